@@ -11,24 +11,27 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v4/modules/core/keeper"
 	"github.com/noble-assets/forwarding/x/forwarding"
 	forwardingkeeper "github.com/noble-assets/forwarding/x/forwarding/keeper"
+	"github.com/noble-assets/noble/v5/cmd"
+	"github.com/noble-assets/noble/v5/x/globalfee"
 	feeante "github.com/noble-assets/noble/v5/x/globalfee/ante"
+	"github.com/noble-assets/noble/v5/x/tokenfactory"
+	tokenfactorykeeper "github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
 )
 
 type HandlerOptions struct {
 	ante.HandlerOptions
+	tokenFactoryKeeper     *tokenfactorykeeper.Keeper
 	fiatTokenFactoryKeeper *fiattokenfactorykeeper.Keeper
 	IBCKeeper              *ibckeeper.Keeper
 	GlobalFeeSubspace      paramtypes.Subspace
 	StakingSubspace        paramtypes.Subspace
+	GlobalFeeKeeper        globalfee.Keeper
 	ForwardingKeeper       *forwardingkeeper.Keeper
-}
 
-// maxTotalBypassMinFeeMsgGasUsage is the allowed maximum gas usage
-// for all the bypass msgs in a transactions.
-// A transaction that contains only bypass message types and the gas usage does not
-// exceed maxTotalBypassMinFeeMsgGasUsage can be accepted with a zero fee.
-// For details, see gaiafeeante.NewFeeDecorator()
-var maxTotalBypassMinFeeMsgGasUsage uint64 = 1_000_000
+	// AnteConfig selects and orders the node-local decorators, which run
+	// after the context is set up and before any consensus-critical check.
+	AnteConfig cmd.AnteConfig
+}
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
@@ -48,9 +51,20 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		sigGasConsumer = ante.DefaultSigVerificationGasConsumer
 	}
 
+	localDecorators, err := newLocalAnteDecorators(options)
+	if err != nil {
+		return nil, err
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		ante.NewRejectExtensionOptionsDecorator(),
+	}
+	anteDecorators = append(anteDecorators, localDecorators...)
+	anteDecorators = append(anteDecorators,
+		tokenfactory.NewIsBlacklistedDecorator(options.tokenFactoryKeeper),
+		tokenfactory.NewIsPausedDecorator(options.tokenFactoryKeeper),
+		tokenfactory.NewTravelRuleDecorator(options.tokenFactoryKeeper),
 		fiattokenfactory.NewIsBlacklistedDecorator(options.fiatTokenFactoryKeeper),
 		fiattokenfactory.NewIsPausedDecorator(options.fiatTokenFactoryKeeper),
 		forwarding.NewAnteDecorator(options.ForwardingKeeper, options.AccountKeeper),
@@ -59,7 +73,8 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		feeante.NewFeeDecorator(options.GlobalFeeSubspace, options.StakingSubspace, maxTotalBypassMinFeeMsgGasUsage),
+		feeante.NewFeeDecorator(options.GlobalFeeSubspace, options.StakingSubspace, options.GlobalFeeKeeper),
+		tokenfactory.NewIsBlacklistedFeeDecorator(options.tokenFactoryKeeper),
 
 		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
 		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
@@ -68,7 +83,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 		ibcante.NewAnteDecorator(options.IBCKeeper),
-	}
+	)
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
 
 }
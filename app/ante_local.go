@@ -0,0 +1,107 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// LocalAnteDecoratorFactory builds a node-local decorator from the handler
+// options.
+type LocalAnteDecoratorFactory func(options HandlerOptions) sdk.AnteDecorator
+
+// localAnteDecorators is the registry of node-local decorators by name.
+var localAnteDecorators = map[string]LocalAnteDecoratorFactory{
+	"max-memo-size": func(options HandlerOptions) sdk.AnteDecorator {
+		return maxMemoSizeDecorator{maxMemoSize: options.AnteConfig.MaxMemoSize}
+	},
+	"max-signatures": func(options HandlerOptions) sdk.AnteDecorator {
+		return maxSignaturesDecorator{maxSignatures: options.AnteConfig.MaxSignatures}
+	},
+}
+
+// RegisterLocalAnteDecorator makes a node-local decorator available under
+// name, so that it can be enabled in app.toml. It panics if name is taken.
+func RegisterLocalAnteDecorator(name string, factory LocalAnteDecoratorFactory) {
+	if _, found := localAnteDecorators[name]; found {
+		panic(fmt.Sprintf("ante decorator %s is already registered", name))
+	}
+	localAnteDecorators[name] = factory
+}
+
+// newLocalAnteDecorators builds the node-local decorators named in the
+// config, in order, each wrapped so that it only runs outside of DeliverTx.
+func newLocalAnteDecorators(options HandlerOptions) ([]sdk.AnteDecorator, error) {
+	var decorators []sdk.AnteDecorator
+	for _, name := range options.AnteConfig.Decorators {
+		factory, found := localAnteDecorators[name]
+		if !found {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown ante decorator %s, available: %s", name, strings.Join(localAnteDecoratorNames(), ", "))
+		}
+		decorators = append(decorators, checkTxDecorator{factory(options)})
+	}
+	return decorators, nil
+}
+
+func localAnteDecoratorNames() []string {
+	names := make([]string, 0, len(localAnteDecorators))
+	for name := range localAnteDecorators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// checkTxDecorator runs the wrapped decorator in CheckTx, ReCheckTx and
+// simulations only, so that a node-local policy never rejects a tx of a block.
+type checkTxDecorator struct {
+	sdk.AnteDecorator
+}
+
+func (cd checkTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !ctx.IsCheckTx() && !simulate {
+		return next(ctx, tx, simulate)
+	}
+	return cd.AnteDecorator.AnteHandle(ctx, tx, simulate, next)
+}
+
+// maxMemoSizeDecorator rejects txs whose memo is longer than maxMemoSize bytes.
+type maxMemoSizeDecorator struct {
+	maxMemoSize uint64
+}
+
+func (md maxMemoSizeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	memoTx, ok := tx.(sdk.TxWithMemo)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+	if size := uint64(len(memoTx.GetMemo())); size > md.maxMemoSize {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrMemoTooLarge, "memo of %d bytes exceeds the node limit of %d bytes", size, md.maxMemoSize)
+	}
+	return next(ctx, tx, simulate)
+}
+
+// maxSignaturesDecorator rejects txs carrying more than maxSignatures
+// signatures.
+type maxSignaturesDecorator struct {
+	maxSignatures uint64
+}
+
+func (sd maxSignaturesDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+	if count := uint64(len(sigs)); count > sd.maxSignatures {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrTooManySignatures, "%d signatures exceed the node limit of %d", count, sd.maxSignatures)
+	}
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,388 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
+	fiattokenfactorytypes "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	paramauthoritytypes "github.com/strangelove-ventures/paramauthority/x/params/types/proposal"
+	paramauthorityupgradetypes "github.com/strangelove-ventures/paramauthority/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/noble-assets/noble/v5/app"
+	"github.com/noble-assets/noble/v5/cmd"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	feeante "github.com/noble-assets/noble/v5/x/globalfee/ante"
+	globalfeetypes "github.com/noble-assets/noble/v5/x/globalfee/types"
+	tokenfactorytypes "github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+const (
+	testChainID       = "noble-test-1"
+	fiatDenom         = "uusdc"
+	tokenFactoryDenom = "ufrienzies"
+)
+
+// testApp is a single validator app, past genesis and inside a block, that
+// transactions can be delivered to directly, like a proposer would.
+type testApp struct {
+	*app.App
+	txConfig client.TxConfig
+
+	account sdk.AccAddress
+	privKey cryptotypes.PrivKey
+}
+
+// setupTestApp initializes the chain with one validator and one funded
+// account, after modify has been applied to the default genesis state.
+func setupTestApp(t *testing.T, modify func(cdc codec.Codec, genesis app.GenesisState)) *testApp {
+	t.Helper()
+	return setupTestAppWithOptions(t, simapp.EmptyAppOptions{}, modify)
+}
+
+// setupTestAppWithOptions is setupTestApp with the given app options.
+func setupTestAppWithOptions(t *testing.T, appOpts servertypes.AppOptions, modify func(cdc codec.Codec, genesis app.GenesisState)) *testApp {
+	t.Helper()
+
+	encoding := cmd.MakeEncodingConfig(app.ModuleBasics)
+	nobleApp, ok := app.New(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), 0,
+		encoding,
+		appOpts,
+	).(*app.App)
+	require.True(t, ok)
+
+	cdc := encoding.Marshaler
+	genesis := app.NewDefaultGenesisState(cdc)
+
+	params := paramauthoritytypes.DefaultGenesis()
+	params.Params.Authority = sample.AccAddress()
+	genesis[paramstypes.ModuleName] = cdc.MustMarshalJSON(params)
+
+	upgrade := paramauthorityupgradetypes.DefaultGenesis()
+	upgrade.Params.Authority = sample.AccAddress()
+	genesis[upgradetypes.ModuleName] = cdc.MustMarshalJSON(upgrade)
+
+	cctp := cctptypes.DefaultGenesis()
+	cctp.Owner = sample.AccAddress()
+	cctp.AttesterManager = sample.AccAddress()
+	cctp.Pauser = sample.AccAddress()
+	cctp.TokenController = sample.AccAddress()
+	genesis[cctptypes.ModuleName] = cdc.MustMarshalJSON(cctp)
+
+	privKey := secp256k1.GenPrivKey()
+	account := sdk.AccAddress(privKey.PubKey().Address())
+	auth := authtypes.NewGenesisState(authtypes.DefaultParams(), authtypes.GenesisAccounts{
+		authtypes.NewBaseAccount(account, nil, 0, 0),
+	})
+	genesis[authtypes.ModuleName] = cdc.MustMarshalJSON(auth)
+
+	valPubKey, err := cryptocodec.FromTmPubKeyInterface(ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(account), valPubKey, stakingtypes.Description{})
+	require.NoError(t, err)
+	bondAmt := sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)
+	validator.Status = stakingtypes.Bonded
+	validator.Tokens = bondAmt
+	validator.DelegatorShares = sdk.NewDecFromInt(bondAmt)
+	staking := stakingtypes.NewGenesisState(stakingtypes.DefaultParams(), []stakingtypes.Validator{validator}, []stakingtypes.Delegation{
+		stakingtypes.NewDelegation(account, validator.GetOperator(), validator.DelegatorShares),
+	})
+	genesis[stakingtypes.ModuleName] = cdc.MustMarshalJSON(staking)
+
+	balances := []banktypes.Balance{
+		{
+			Address: account.String(),
+			Coins:   sdk.NewCoins(sdk.NewInt64Coin(fiatDenom, 1_000_000_000)),
+		},
+		{
+			Address: authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, bondAmt)),
+		},
+	}
+	supply := sdk.NewCoins()
+	for _, balance := range balances {
+		supply = supply.Add(balance.Coins...)
+	}
+	var metadata []banktypes.Metadata
+	for _, denom := range []string{fiatDenom, tokenFactoryDenom} {
+		metadata = append(metadata, banktypes.Metadata{
+			Base:       denom,
+			Display:    denom,
+			DenomUnits: []*banktypes.DenomUnit{{Denom: denom}},
+		})
+	}
+	bank := banktypes.NewGenesisState(banktypes.DefaultParams(), balances, supply, metadata)
+	genesis[banktypes.ModuleName] = cdc.MustMarshalJSON(bank)
+
+	fiatTokenFactory := fiattokenfactorytypes.DefaultGenesis()
+	fiatTokenFactory.MintingDenom = &fiattokenfactorytypes.MintingDenom{Denom: fiatDenom}
+	fiatTokenFactory.Paused = &fiattokenfactorytypes.Paused{Paused: false}
+	genesis[fiattokenfactorytypes.ModuleName] = cdc.MustMarshalJSON(fiatTokenFactory)
+
+	tokenFactory := tokenfactorytypes.DefaultGenesis()
+	tokenFactory.MintingDenom = &tokenfactorytypes.MintingDenom{Denom: tokenFactoryDenom}
+	tokenFactory.Paused = &tokenfactorytypes.Paused{Paused: false}
+	genesis[tokenfactorytypes.ModuleName] = cdc.MustMarshalJSON(tokenFactory)
+
+	if modify != nil {
+		modify(cdc, genesis)
+	}
+
+	stateBytes, err := json.Marshal(genesis)
+	require.NoError(t, err)
+
+	nobleApp.InitChain(abci.RequestInitChain{
+		ChainId:         testChainID,
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	nobleApp.Commit()
+	nobleApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{
+		ChainID: testChainID,
+		Height:  nobleApp.LastBlockHeight() + 1,
+		AppHash: nobleApp.LastCommitID().Hash,
+		Time:    time.Now().UTC(),
+	}})
+
+	return &testApp{
+		App:      nobleApp,
+		txConfig: encoding.TxConfig,
+		account:  account,
+		privKey:  privKey,
+	}
+}
+
+// signTx signs msgs by the funded account with its account number and
+// sequence in the CheckTx state.
+func (a *testApp) signTx(t *testing.T, msgs []sdk.Msg, fee sdk.Coins, gas uint64) []byte {
+	t.Helper()
+
+	ctx := a.BaseApp.NewContext(true, tmproto.Header{ChainID: testChainID})
+	acc := a.AccountKeeper.GetAccount(ctx, a.account)
+	tx, err := helpers.GenTx(a.txConfig, msgs, fee, gas, testChainID, []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, a.privKey)
+	require.NoError(t, err)
+	txBytes, err := a.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	return txBytes
+}
+
+// TestGlobalFeeMaliciousProposer delivers a transaction that does not pay the
+// global fee straight into a block, skipping CheckTx like a malicious proposer.
+func TestGlobalFeeMaliciousProposer(t *testing.T) {
+	tests := map[string]struct {
+		enforce bool
+		expCode uint32
+	}{
+		"global fee not enforced in DeliverTx": {
+			enforce: false,
+			expCode: abci.CodeTypeOK,
+		},
+		"global fee enforced in DeliverTx": {
+			enforce: true,
+			expCode: sdkerrors.ErrInsufficientFee.ABCICode(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := setupTestApp(t, func(cdc codec.Codec, genesis app.GenesisState) {
+				globalfee := globalfeetypes.DefaultGenesisState()
+				globalfee.Params.MinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(fiatDenom, sdk.NewDecWithPrec(1, 1)))
+				globalfee.Params.EnforceGlobalFeeInDeliverTx = test.enforce
+				genesis[globalfeetypes.ModuleName] = cdc.MustMarshalJSON(globalfee)
+			})
+
+			msg := banktypes.NewMsgSend(a.account, sdk.AccAddress(sample.AddressBz()), sdk.NewCoins(sdk.NewInt64Coin(fiatDenom, 1)))
+			txBytes := a.signTx(t, []sdk.Msg{msg}, nil, 200_000)
+
+			// an honest validator never lets the tx into its mempool
+			checkRes := a.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+			require.Equal(t, sdkerrors.ErrInsufficientFee.ABCICode(), checkRes.Code, checkRes.Log)
+
+			deliverRes := a.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+			require.Equal(t, test.expCode, deliverRes.Code, deliverRes.Log)
+		})
+	}
+}
+
+// TestGlobalFeeTxPriority checks the priority that CheckTx hands to the mempool.
+func TestGlobalFeeTxPriority(t *testing.T) {
+	a := setupTestApp(t, func(cdc codec.Codec, genesis app.GenesisState) {
+		globalfee := globalfeetypes.DefaultGenesisState()
+		globalfee.Params.MinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(fiatDenom, sdk.NewDecWithPrec(1, 1)))
+		globalfee.Params.BypassMinFeeTxPriority = 3 * feeante.BaseTxPriority
+		genesis[globalfeetypes.ModuleName] = cdc.MustMarshalJSON(globalfee)
+	})
+
+	send := banktypes.NewMsgSend(a.account, sdk.AccAddress(sample.AddressBz()), sdk.NewCoins(sdk.NewInt64Coin(fiatDenom, 1)))
+	txBytes := a.signTx(t, []sdk.Msg{send}, sdk.NewCoins(sdk.NewInt64Coin(fiatDenom, 40_000)), 200_000)
+	res := a.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.Equal(t, abci.CodeTypeOK, res.Code, res.Log)
+	require.Equal(t, 2*feeante.BaseTxPriority, res.Priority)
+
+	transfer := ibctransfertypes.NewMsgTransfer(
+		ibctransfertypes.PortID, "channel-0", sdk.NewInt64Coin(fiatDenom, 1),
+		a.account.String(), sample.AccAddress(), clienttypes.NewHeight(1, 100), 0,
+	)
+	txBytes = a.signTx(t, []sdk.Msg{transfer}, nil, 200_000)
+	res = a.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.Equal(t, abci.CodeTypeOK, res.Code, res.Log)
+	require.Equal(t, 3*feeante.BaseTxPriority, res.Priority)
+}
+
+func TestGlobalFeeRequiredFeesQuery(t *testing.T) {
+	a := setupTestApp(t, func(cdc codec.Codec, genesis app.GenesisState) {
+		globalfee := globalfeetypes.DefaultGenesisState()
+		globalfee.Params.MinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(fiatDenom, sdk.NewDecWithPrec(1, 1)))
+		genesis[globalfeetypes.ModuleName] = cdc.MustMarshalJSON(globalfee)
+	})
+
+	query := func(req *globalfeetypes.QueryRequiredFeesRequest) (*globalfeetypes.QueryRequiredFeesResponse, abci.ResponseQuery) {
+		res := a.Query(abci.RequestQuery{
+			Path: "/noble.globalfee.Query/RequiredFees",
+			Data: a.AppCodec().MustMarshal(req),
+		})
+		var out globalfeetypes.QueryRequiredFeesResponse
+		if res.IsOK() {
+			a.AppCodec().MustUnmarshal(res.Value, &out)
+		}
+		return &out, res
+	}
+
+	send := banktypes.NewMsgSend(a.account, sdk.AccAddress(sample.AddressBz()), sdk.NewCoins(sdk.NewInt64Coin(fiatDenom, 1)))
+	res, raw := query(&globalfeetypes.QueryRequiredFeesRequest{TxBytes: a.signTx(t, []sdk.Msg{send}, nil, 200_000)})
+	require.True(t, raw.IsOK(), raw.Log)
+	require.False(t, res.BypassMinFee)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(fiatDenom, 20_000)), res.RequiredFees)
+
+	// the required fees are accepted
+	txBytes := a.signTx(t, []sdk.Msg{send}, res.RequiredFees, 200_000)
+	checkRes := a.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.Equal(t, abci.CodeTypeOK, checkRes.Code, checkRes.Log)
+
+	res, raw = query(&globalfeetypes.QueryRequiredFeesRequest{
+		MsgTypeUrls: []string{sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{})},
+		GasLimit:    200_000,
+	})
+	require.True(t, raw.IsOK(), raw.Log)
+	require.True(t, res.BypassMinFee)
+	require.Empty(t, res.RequiredFees)
+
+	_, raw = query(&globalfeetypes.QueryRequiredFeesRequest{})
+	require.False(t, raw.IsOK())
+}
+
+func TestTokenFactoryBlacklistedDecorator(t *testing.T) {
+	blacklisted := sample.TestAccount()
+	a := setupTestApp(t, func(cdc codec.Codec, genesis app.GenesisState) {
+		var tokenFactory tokenfactorytypes.GenesisState
+		cdc.MustUnmarshalJSON(genesis[tokenfactorytypes.ModuleName], &tokenFactory)
+		tokenFactory.BlacklistedList = []tokenfactorytypes.Blacklisted{{AddressBz: blacklisted.AddressBz}}
+		genesis[tokenfactorytypes.ModuleName] = cdc.MustMarshalJSON(&tokenFactory)
+	})
+
+	send := banktypes.NewMsgSend(a.account, sdk.MustAccAddressFromBech32(blacklisted.Address), sdk.NewCoins(sdk.NewInt64Coin(tokenFactoryDenom, 1)))
+	res := a.CheckTx(abci.RequestCheckTx{Tx: a.signTx(t, []sdk.Msg{send}, nil, 200_000)})
+	require.Equal(t, tokenfactorytypes.ModuleName, res.Codespace, res.Log)
+	require.Equal(t, tokenfactorytypes.ErrUnauthorized.ABCICode(), res.Code, res.Log)
+
+	exec := authz.NewMsgExec(a.account, []sdk.Msg{send})
+	res = a.CheckTx(abci.RequestCheckTx{Tx: a.signTx(t, []sdk.Msg{&exec}, nil, 200_000)})
+	require.Equal(t, tokenfactorytypes.ModuleName, res.Codespace, res.Log)
+	require.Equal(t, tokenfactorytypes.ErrUnauthorized.ABCICode(), res.Code, res.Log)
+}
+
+func TestTokenFactoryRestrictedBankKeeper(t *testing.T) {
+	blacklisted := sample.TestAccount()
+	a := setupTestApp(t, func(cdc codec.Codec, genesis app.GenesisState) {
+		var tokenFactory tokenfactorytypes.GenesisState
+		cdc.MustUnmarshalJSON(genesis[tokenfactorytypes.ModuleName], &tokenFactory)
+		tokenFactory.BlacklistedList = []tokenfactorytypes.Blacklisted{{AddressBz: blacklisted.AddressBz}}
+		genesis[tokenfactorytypes.ModuleName] = cdc.MustMarshalJSON(&tokenFactory)
+	})
+	ctx := a.NewContext(false, tmproto.Header{ChainID: testChainID, Height: a.LastBlockHeight() + 1})
+
+	// a module moving the minting denom directly, as forwarding or PFM would
+	coins := sdk.NewCoins(sdk.NewInt64Coin(tokenFactoryDenom, 1))
+	require.NoError(t, a.BankKeeper.MintCoins(ctx, tokenfactorytypes.ModuleName, coins))
+	err := a.BankKeeper.SendCoinsFromModuleToAccount(ctx, tokenfactorytypes.ModuleName, blacklisted.AddressBz, coins)
+	require.ErrorIs(t, err, tokenfactorytypes.ErrUnauthorized)
+	require.NoError(t, a.BankKeeper.SendCoinsFromModuleToAccount(ctx, tokenfactorytypes.ModuleName, a.account, coins))
+	err = a.BankKeeper.SendCoins(ctx, a.account, blacklisted.AddressBz, coins)
+	require.ErrorIs(t, err, tokenfactorytypes.ErrUnauthorized)
+
+	a.TokenFactoryKeeper.SetPaused(ctx, tokenFactoryDenom, tokenfactorytypes.Paused{Paused: true})
+	err = a.BankKeeper.SendCoins(ctx, a.account, sdk.AccAddress(sample.AddressBz()), coins)
+	require.ErrorIs(t, err, tokenfactorytypes.ErrPaused)
+}
+
+func TestTokenFactoryBlacklistedFeeDecorator(t *testing.T) {
+	a := setupTestApp(t, nil)
+	ctx := a.NewContext(true, tmproto.Header{ChainID: testChainID})
+	a.TokenFactoryKeeper.SetBlacklisted(ctx, tokenFactoryDenom, tokenfactorytypes.Blacklisted{AddressBz: a.account})
+
+	// the blacklisted account can still sign txs that do not move the minting denom
+	send := banktypes.NewMsgSend(a.account, sdk.AccAddress(sample.AddressBz()), sdk.NewCoins(sdk.NewInt64Coin(fiatDenom, 1)))
+	res := a.CheckTx(abci.RequestCheckTx{Tx: a.signTx(t, []sdk.Msg{send}, nil, 200_000)})
+	require.True(t, res.IsOK(), res.Log)
+
+	params := tokenfactorytypes.DefaultParams()
+	params.RejectBlacklistedSigners = true
+	a.TokenFactoryKeeper.SetParams(ctx, params)
+	res = a.CheckTx(abci.RequestCheckTx{Tx: a.signTx(t, []sdk.Msg{send}, nil, 200_000)})
+	require.Equal(t, tokenfactorytypes.ModuleName, res.Codespace, res.Log)
+	require.Equal(t, tokenfactorytypes.ErrUnauthorized.ABCICode(), res.Code, res.Log)
+}
+
+// appOptions is a servertypes.AppOptions backed by a map.
+type appOptions map[string]interface{}
+
+func (o appOptions) Get(key string) interface{} { return o[key] }
+
+func TestLocalAnteDecorators(t *testing.T) {
+	a := setupTestAppWithOptions(t, appOptions{
+		cmd.FlagAnteDecorators:    []string{"max-memo-size", "max-signatures"},
+		cmd.FlagAnteMaxSignatures: 0,
+	}, nil)
+
+	send := banktypes.NewMsgSend(a.account, sdk.AccAddress(sample.AddressBz()), sdk.NewCoins(sdk.NewInt64Coin(fiatDenom, 1)))
+	txBytes := a.signTx(t, []sdk.Msg{send}, nil, 200_000)
+
+	res := a.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.Equal(t, sdkerrors.ErrTooManySignatures.ABCICode(), res.Code, res.Log)
+
+	// node-local decorators never reject a tx of a block
+	deliverRes := a.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, deliverRes.IsOK(), deliverRes.Log)
+
+	require.Panics(t, func() {
+		setupTestAppWithOptions(t, appOptions{cmd.FlagAnteDecorators: []string{"unknown"}}, nil)
+	})
+}
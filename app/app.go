@@ -85,6 +85,7 @@ import (
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	fiattokenfactorymodule "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory"
@@ -93,10 +94,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/noble-assets/noble/v5/app/upgrades/krypton"
 	"github.com/noble-assets/noble/v5/cmd"
 	"github.com/noble-assets/noble/v5/docs"
 	"github.com/noble-assets/noble/v5/x/blockibc"
 	"github.com/noble-assets/noble/v5/x/globalfee"
+	feeante "github.com/noble-assets/noble/v5/x/globalfee/ante"
+	globalfeetypes "github.com/noble-assets/noble/v5/x/globalfee/types"
 	tariff "github.com/noble-assets/noble/v5/x/tariff"
 	tariffkeeper "github.com/noble-assets/noble/v5/x/tariff/keeper"
 	tarifftypes "github.com/noble-assets/noble/v5/x/tariff/types"
@@ -194,6 +198,10 @@ type App struct {
 	cdc               *codec.LegacyAmino
 	appCodec          codec.Codec
 	interfaceRegistry types.InterfaceRegistry
+	txDecoder         sdk.TxDecoder
+
+	// feeDecorator computes the priority of txs in CheckTx
+	feeDecorator feeante.FeeDecorator
 
 	invCheckPeriod uint
 
@@ -231,6 +239,7 @@ type App struct {
 	TariffKeeper           tariffkeeper.Keeper
 	CCTPKeeper             *cctpkeeper.Keeper
 	ForwardingKeeper       *forwardingkeeper.Keeper
+	GlobalFeeKeeper        globalfee.Keeper
 
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
@@ -269,7 +278,7 @@ func New(
 		paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey, evidencetypes.StoreKey,
 		ibctransfertypes.StoreKey, icahosttypes.StoreKey, capabilitytypes.StoreKey,
 		tokenfactorymoduletypes.StoreKey, fiattokenfactorymoduletypes.StoreKey, packetforwardtypes.StoreKey, stakingtypes.StoreKey,
-		cctptypes.StoreKey, forwardingtypes.StoreKey,
+		cctptypes.StoreKey, forwardingtypes.StoreKey, globalfeetypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(
 		paramstypes.TStoreKey,
@@ -282,6 +291,7 @@ func New(
 		cdc:               cdc,
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
+		txDecoder:         encodingConfig.TxConfig.TxDecoder(),
 		invCheckPeriod:    invCheckPeriod,
 		keys:              keys,
 		tkeys:             tkeys,
@@ -326,7 +336,7 @@ func New(
 		app.MsgServiceRouter(),
 	)
 
-	app.BankKeeper = bankkeeper.NewBaseKeeper(
+	bankKeeper := bankkeeper.NewBaseKeeper(
 		appCodec,
 		keys[banktypes.StoreKey],
 		app.AccountKeeper,
@@ -334,6 +344,19 @@ func New(
 		app.BlockedModuleAccountAddrs(),
 	)
 
+	app.TokenFactoryKeeper = tokenfactorymodulekeeper.NewKeeper(
+		appCodec,
+		keys[tokenfactorymoduletypes.StoreKey],
+		app.GetSubspace(tokenfactorymoduletypes.ModuleName),
+
+		bankKeeper,
+		app.ParamsKeeper,
+	)
+
+	// Every other module moves coins through the restricted bank keeper, so
+	// that the tokenfactory blacklist and pause apply whatever the entry point.
+	app.BankKeeper = tokenfactorymodulekeeper.NewRestrictedBankKeeper(bankKeeper, app.TokenFactoryKeeper)
+
 	app.StakingKeeper = stakingkeeper.NewKeeper(
 		appCodec,
 		keys[stakingtypes.StoreKey],
@@ -399,6 +422,13 @@ func New(
 		scopedIBCKeeper,
 	)
 
+	app.GlobalFeeKeeper = globalfee.NewKeeper(
+		appCodec,
+		keys[globalfeetypes.StoreKey],
+		app.GetSubspace(globalfee.ModuleName),
+	)
+	app.feeDecorator = feeante.NewFeeDecorator(app.GetSubspace(globalfee.ModuleName), app.GetSubspace(stakingtypes.ModuleName), app.GlobalFeeKeeper)
+
 	app.TariffKeeper = tariffkeeper.NewKeeper(
 		app.GetSubspace(tarifftypes.ModuleName),
 		app.AccountKeeper,
@@ -457,13 +487,6 @@ func New(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
 
-	app.TokenFactoryKeeper = tokenfactorymodulekeeper.NewKeeper(
-		appCodec,
-		keys[tokenfactorymoduletypes.StoreKey],
-		app.GetSubspace(tokenfactorymoduletypes.ModuleName),
-
-		app.BankKeeper,
-	)
 	tokenfactoryModule := tokenfactorymodule.NewAppModule(appCodec, app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper)
 
 	app.FiatTokenFactoryKeeper = fiattokenfactorymodulekeeper.NewKeeper(
@@ -530,7 +553,7 @@ func New(
 		auth.NewAppModule(appCodec, app.AccountKeeper, nil),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		newRestrictedBankModule(appCodec, app.BankKeeper, bankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
@@ -546,7 +569,12 @@ func New(
 		fiattokenfactorymodule,
 		packetforward.NewAppModule(app.PacketForwardKeeper),
 		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
-		globalfee.NewAppModule(app.GetSubspace(globalfee.ModuleName)),
+		globalfee.NewAppModule(
+			app.GetSubspace(globalfee.ModuleName),
+			app.GlobalFeeKeeper,
+			encodingConfig.TxConfig.TxDecoder(),
+			app.feeDecorator,
+		),
 		tariff.NewAppModule(appCodec, app.TariffKeeper, app.AccountKeeper, app.BankKeeper),
 		cctp.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.CCTPKeeper),
 		forwarding.NewAppModule(app.ForwardingKeeper),
@@ -682,13 +710,17 @@ func New(
 				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
+			tokenFactoryKeeper:     app.TokenFactoryKeeper,
 			fiatTokenFactoryKeeper: app.FiatTokenFactoryKeeper,
 
 			IBCKeeper:         app.IBCKeeper,
 			GlobalFeeSubspace: app.GetSubspace(globalfee.ModuleName),
 			StakingSubspace:   app.GetSubspace(stakingtypes.ModuleName),
+			GlobalFeeKeeper:   app.GlobalFeeKeeper,
 
 			ForwardingKeeper: app.ForwardingKeeper,
+
+			AnteConfig: cmd.NewAnteConfigFromAppOptions(appOpts),
 		},
 	)
 	if err != nil {
@@ -720,6 +752,26 @@ func (app *App) Name() string { return app.BaseApp.Name() }
 // GetBaseApp returns the base app of the application
 func (app App) GetBaseApp() *baseapp.BaseApp { return app.BaseApp }
 
+// CheckTx sets the priority of accepted txs on the response, which SDK v0.45
+// does not do itself, so the mempool can order txs by the fee they pay.
+func (app *App) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	res := app.BaseApp.CheckTx(req)
+	if !res.IsOK() {
+		return res
+	}
+
+	tx, err := app.txDecoder(req.Tx)
+	if err != nil {
+		return res
+	}
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+	if priority, err := app.feeDecorator.TxPriority(ctx, tx); err == nil {
+		res.Priority = priority
+	}
+
+	return res
+}
+
 // BeginBlocker application updates every begin block
 func (app *App) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	return app.mm.BeginBlock(ctx, req)
@@ -877,6 +929,15 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 }
 
 func (app *App) setupUpgradeHandlers() {
+	// krypton upgrade
+	app.UpgradeKeeper.SetUpgradeHandler(
+		krypton.UpgradeName,
+		krypton.CreateUpgradeHandler(
+			app.mm,
+			app.configurator,
+		),
+	)
+
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(fmt.Errorf("failed to read upgrade info from disk: %w", err))
@@ -888,6 +949,10 @@ func (app *App) setupUpgradeHandlers() {
 	var storeLoader baseapp.StoreLoader
 
 	switch upgradeInfo.Name {
+	case krypton.UpgradeName:
+		storeLoader = upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storetypes.StoreUpgrades{
+			Added: []string{globalfeetypes.StoreKey},
+		})
 	}
 
 	if storeLoader != nil {
@@ -0,0 +1,40 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// restrictedBankModule is the bank module with its msg and query servers
+// backed by a wrapped keeper, such as the tokenfactory RestrictedBankKeeper.
+// The bank module itself requires a BaseKeeper for its migrations.
+type restrictedBankModule struct {
+	bank.AppModule
+	keeper     bankkeeper.Keeper
+	baseKeeper bankkeeper.BaseKeeper
+}
+
+func newRestrictedBankModule(cdc codec.Codec, keeper bankkeeper.Keeper, baseKeeper bankkeeper.BaseKeeper, accountKeeper banktypes.AccountKeeper) restrictedBankModule {
+	return restrictedBankModule{
+		AppModule:  bank.NewAppModule(cdc, baseKeeper, accountKeeper),
+		keeper:     keeper,
+		baseKeeper: baseKeeper,
+	}
+}
+
+// RegisterServices registers the bank services like bank.AppModule does, but
+// with the wrapped keeper.
+func (am restrictedBankModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.baseKeeper)
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", banktypes.ModuleName, err))
+	}
+}
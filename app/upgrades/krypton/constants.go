@@ -0,0 +1,4 @@
+package krypton
+
+// UpgradeName is the name of this specific software upgrade used on-chain.
+const UpgradeName = "krypton"
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	FlagAnteDecorators    = "ante.decorators"
+	FlagAnteMaxMemoSize   = "ante.max-memo-size"
+	FlagAnteMaxSignatures = "ante.max-signatures"
+)
+
+// DefaultAnteConfigTemplate is the app.toml section configuring the
+// node-local ante decorators.
+const DefaultAnteConfigTemplate = `
+###############################################################################
+###                         Ante Handler Configuration                      ###
+###############################################################################
+
+[ante]
+
+# Node-local ante decorators to run, in order, on txs entering the mempool.
+# They are skipped when delivering blocks, so they can't affect consensus.
+# Available: max-memo-size, max-signatures.
+decorators = [{{ range .Ante.Decorators }}{{ printf "%q, " . }}{{end}}]
+
+# Max memo length, in bytes, accepted by the max-memo-size decorator.
+max-memo-size = {{ .Ante.MaxMemoSize }}
+
+# Max number of signatures accepted by the max-signatures decorator.
+max-signatures = {{ .Ante.MaxSignatures }}
+`
+
+// AnteConfig configures the node-local ante decorators.
+type AnteConfig struct {
+	// Decorators are the names of the node-local decorators to run, in order.
+	Decorators    []string `mapstructure:"decorators"`
+	MaxMemoSize   uint64   `mapstructure:"max-memo-size"`
+	MaxSignatures uint64   `mapstructure:"max-signatures"`
+}
+
+// DefaultAnteConfig returns a config that runs no node-local decorator.
+func DefaultAnteConfig() AnteConfig {
+	return AnteConfig{
+		Decorators:    []string{},
+		MaxMemoSize:   256,
+		MaxSignatures: 7,
+	}
+}
+
+// NewAnteConfigFromAppOptions reads the [ante] section of app.toml, falling
+// back to the defaults for what is not set.
+func NewAnteConfigFromAppOptions(appOpts servertypes.AppOptions) AnteConfig {
+	config := DefaultAnteConfig()
+	if v := appOpts.Get(FlagAnteDecorators); v != nil {
+		config.Decorators = cast.ToStringSlice(v)
+	}
+	if v := appOpts.Get(FlagAnteMaxMemoSize); v != nil {
+		config.MaxMemoSize = cast.ToUint64(v)
+	}
+	if v := appOpts.Get(FlagAnteMaxSignatures); v != nil {
+		config.MaxSignatures = cast.ToUint64(v)
+	}
+	return config
+}
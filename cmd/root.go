@@ -420,6 +420,8 @@ func (a appCreator) appExport(
 func initAppConfig() (string, interface{}) {
 	type CustomAppConfig struct {
 		serverconfig.Config
+
+		Ante AnteConfig `mapstructure:"ante"`
 	}
 
 	srvCfg := serverconfig.DefaultConfig()
@@ -428,7 +430,8 @@ func initAppConfig() (string, interface{}) {
 
 	config := CustomAppConfig{
 		Config: *srvCfg,
+		Ante:   DefaultAnteConfig(),
 	}
 
-	return serverconfig.DefaultConfigTemplate, config
+	return serverconfig.DefaultConfigTemplate + DefaultAnteConfigTemplate, config
 }
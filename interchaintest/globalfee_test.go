@@ -125,6 +125,7 @@ func TestGlobalFee(t *testing.T) {
 	// send tx with zero fees while the default MinimumGasPricesParam requires fees, but update owner msg is in the bypass min fee msgs list - tx should succeed
 	_, err = nobleValidator.ExecTx(ctx, gw.tfRoles.Owner.KeyName(),
 		"tokenfactory", "update-owner", gw.tfRoles.Owner2.FormattedAddress(),
+		"--denom", denomMetadataFrienzies.Base,
 		"--gas-prices", zeroGasPrice,
 		"-b", "block",
 	)
@@ -133,6 +134,7 @@ func TestGlobalFee(t *testing.T) {
 	// send tx with zero fees while the default MinimumGasPricesParam requires fees, but accept owner msg is in the bypass min fee msgs list - tx should succeed
 	_, err = nobleValidator.ExecTx(ctx, gw.tfRoles.Owner2.KeyName(),
 		"tokenfactory", "accept-owner",
+		"--denom", denomMetadataFrienzies.Base,
 		"--gas-prices", zeroGasPrice,
 		"-b", "block",
 	)
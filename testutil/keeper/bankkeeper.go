@@ -25,3 +25,26 @@ func (MockBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr s
 func (MockBankKeeper) GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool) {
 	return banktypes.Metadata{}, true
 }
+func (MockBankKeeper) SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata) {}
+func (MockBankKeeper) GetSupply(ctx sdk.Context, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, sdk.ZeroInt())
+}
+
+// MockMetadataBankKeeper is a MockBankKeeper that only finds the denom
+// metadata that has been set.
+type MockMetadataBankKeeper struct {
+	MockBankKeeper
+	metadata map[string]banktypes.Metadata
+}
+
+func NewMockMetadataBankKeeper() MockMetadataBankKeeper {
+	return MockMetadataBankKeeper{metadata: make(map[string]banktypes.Metadata)}
+}
+
+func (k MockMetadataBankKeeper) GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool) {
+	metadata, found := k.metadata[denom]
+	return metadata, found
+}
+func (k MockMetadataBankKeeper) SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata) {
+	k.metadata[denomMetaData.Base] = denomMetaData
+}
@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// MockAuthority is the authority of the chain returned by MockParamsKeeper.
+var MockAuthority = authtypes.NewModuleAddress("authority").String()
+
+type MockParamsKeeper struct{}
+
+func (MockParamsKeeper) GetAuthority(ctx sdk.Context) string {
+	return MockAuthority
+}
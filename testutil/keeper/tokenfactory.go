@@ -18,11 +18,17 @@ import (
 )
 
 func TokenfactoryKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
+	return TokenfactoryKeeperWithBankKeeper(t, MockBankKeeper{})
+}
+
+func TokenfactoryKeeperWithBankKeeper(t testing.TB, bankKeeper types.BankKeeper) (*keeper.Keeper, sdk.Context) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey(types.ModuleName + "_transient")
 
 	db := tmdb.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db)
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(tStoreKey, storetypes.StoreTypeTransient, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
@@ -31,14 +37,15 @@ func TokenfactoryKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	paramsSubspace := typesparams.NewSubspace(cdc,
 		codec.NewLegacyAmino(),
 		storeKey,
-		nil,
+		tStoreKey,
 		"TokenfactoryParams",
 	)
 	k := keeper.NewKeeper(
 		cdc,
 		storeKey,
 		paramsSubspace,
-		MockBankKeeper{},
+		bankKeeper,
+		MockParamsKeeper{},
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
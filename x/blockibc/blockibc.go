@@ -87,7 +87,8 @@ func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID st
 
 // OnRecvPacket intercepts the packet data and checks the sender and receiver address against
 // the blacklisted addresses held in the tokenfactory keeper. If the address is found in the blacklist, an
-// acknowledgment error is returned.
+// acknowledgment error is returned, unless it is the receiver of a tokenfactory asset while the
+// AllowBlacklistedIBCReceive param is enabled.
 func (im IBCMiddleware) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -103,16 +104,17 @@ func (im IBCMiddleware) OnRecvPacket(
 
 	denomTrace := transfertypes.ParseDenomTrace(data.Denom)
 
-	tfMintingDenom := im.keeper.GetMintingDenom(ctx)
+	baseDenom := denomTrace.BaseDenom
+	isTfMintingDenom := im.keeper.HasMintingDenom(ctx, baseDenom)
 	ctfMintingDenom := im.fiatKeeper.GetMintingDenom(ctx)
 
 	switch {
 	// denom is not tokenfactory denom
-	case denomTrace.BaseDenom != tfMintingDenom.Denom && denomTrace.BaseDenom != ctfMintingDenom.Denom:
+	case !isTfMintingDenom && baseDenom != ctfMintingDenom.Denom:
 		return im.app.OnRecvPacket(ctx, packet, relayer)
 	// denom is tokenfactory asset
-	case denomTrace.BaseDenom == tfMintingDenom.Denom:
-		if im.keeper.GetPaused(ctx).Paused {
+	case isTfMintingDenom:
+		if im.keeper.GetPaused(ctx, baseDenom).Paused {
 			return channeltypes.NewErrorAcknowledgement(types.ErrPaused)
 		}
 
@@ -121,10 +123,13 @@ func (im IBCMiddleware) OnRecvPacket(
 			return channeltypes.NewErrorAcknowledgement(err)
 		}
 
-		_, found := im.keeper.GetBlacklisted(ctx, addressBz)
+		_, found := im.keeper.GetBlacklisted(ctx, baseDenom, addressBz)
 		if found {
-			ackErr = sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "receiver address is blacklisted")
-			return channeltypes.NewErrorAcknowledgement(ackErr)
+			if !im.keeper.AllowBlacklistedIBCReceive(ctx) {
+				ackErr = sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "receiver address is blacklisted")
+				return channeltypes.NewErrorAcknowledgement(ackErr)
+			}
+			ctx = keeper.WithAllowedReceiver(ctx, addressBz)
 		}
 
 		_, addressBz, err = bech32.DecodeAndConvert(data.Sender)
@@ -132,13 +137,13 @@ func (im IBCMiddleware) OnRecvPacket(
 			return channeltypes.NewErrorAcknowledgement(err)
 		}
 
-		_, found = im.keeper.GetBlacklisted(ctx, addressBz)
+		_, found = im.keeper.GetBlacklisted(ctx, baseDenom, addressBz)
 		if found {
 			ackErr = sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "sender address is blacklisted")
 			return channeltypes.NewErrorAcknowledgement(ackErr)
 		}
 	// denom is fiat-tokenfactory asset
-	case denomTrace.BaseDenom == ctfMintingDenom.Denom:
+	case baseDenom == ctfMintingDenom.Denom:
 		if im.fiatKeeper.GetPaused(ctx).Paused {
 			return channeltypes.NewErrorAcknowledgement(fiat_types.ErrPaused)
 		}
@@ -0,0 +1,556 @@
+package antetest
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/noble-assets/noble/v5/x/globalfee"
+	"github.com/noble-assets/noble/v5/x/globalfee/ante"
+	"github.com/noble-assets/noble/v5/x/globalfee/types"
+)
+
+const testMsgTypeURL = "/testdata.TestMsg"
+
+type feeDecoratorTestSuite struct {
+	suite.Suite
+
+	ctx               sdk.Context
+	globalfeeSubspace paramstypes.Subspace
+	stakingSubspace   paramstypes.Subspace
+	globalfeeKeeper   globalfee.Keeper
+}
+
+func TestFeeDecoratorTestSuite(t *testing.T) {
+	suite.Run(t, new(feeDecoratorTestSuite))
+}
+
+func (s *feeDecoratorTestSuite) SetupTest() {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	encCfg := simapp.MakeTestEncodingConfig()
+	keyParams := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	keyGlobalFee := sdk.NewKVStoreKey(types.StoreKey)
+	ms.MountStoreWithDB(keyParams, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, storetypes.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyGlobalFee, storetypes.StoreTypeIAVL, db)
+	s.Require().NoError(ms.LoadLatestVersion())
+
+	paramsKeeper := paramskeeper.NewKeeper(encCfg.Marshaler, encCfg.Amino, keyParams, tkeyParams)
+
+	s.ctx = sdk.NewContext(ms, tmproto.Header{
+		Height: 1,
+		Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+	}, true, log.NewNopLogger())
+
+	s.globalfeeSubspace = paramsKeeper.Subspace(globalfee.ModuleName).WithKeyTable(types.ParamKeyTable())
+	s.stakingSubspace = paramsKeeper.Subspace(stakingtypes.ModuleName).WithKeyTable(stakingtypes.ParamKeyTable())
+	s.globalfeeKeeper = globalfee.NewKeeper(encCfg.Marshaler, keyGlobalFee, s.globalfeeSubspace)
+
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.BondDenom = "ustake"
+	s.stakingSubspace.SetParamSet(s.ctx, &stakingParams)
+}
+
+// setParams stores the global fee params, modified by modify, starting from
+// the default params with a global minimum gas price of 0.1uusdc.
+func (s *feeDecoratorTestSuite) setParams(modify func(p *types.Params)) {
+	params := types.DefaultParams()
+	params.MinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("uusdc", sdk.NewDecWithPrec(1, 1)))
+	modify(&params)
+	s.globalfeeSubspace.SetParamSet(s.ctx, &params)
+}
+
+// anteHandle runs the fee decorator in the mode of s.ctx on a tx with the given msgs, fee and gas.
+func (s *feeDecoratorTestSuite) anteHandle(msgs []sdk.Msg, fee sdk.Coins, gas uint64) error {
+	decorator := ante.NewFeeDecorator(s.globalfeeSubspace, s.stakingSubspace, s.globalfeeKeeper)
+	_, err := decorator.AnteHandle(s.ctx, mockFeeTx{msgs: msgs, fee: fee, gas: gas}, false, nextAnteHandler)
+	return err
+}
+
+func nextAnteHandler(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+	return ctx, nil
+}
+
+func (s *feeDecoratorTestSuite) TestBypassMinFeeAddresses() {
+	_, _, trusted := testdata.KeyTestPubAddr()
+	_, _, untrusted := testdata.KeyTestPubAddr()
+	paidFee := sdk.NewCoins(sdk.NewCoin("uusdc", sdk.NewInt(20_000)))
+
+	tests := map[string]struct {
+		params func(p *types.Params)
+		msgs   []sdk.Msg
+		fee    sdk.Coins
+		gas    uint64
+		expErr bool
+	}{
+		"trusted signer, address restricted msg type, zero fee": {
+			params: func(p *types.Params) {
+				p.AddressRestrictedBypassMinFeeMsgTypes = []string{testMsgTypeURL}
+				p.BypassMinFeeAddresses = []string{trusted.String()}
+			},
+			msgs: []sdk.Msg{testdata.NewTestMsg(trusted)},
+			gas:  200_000,
+		},
+		"trusted signer, address restricted msg type, zero fee, gas above bypass limit": {
+			params: func(p *types.Params) {
+				p.AddressRestrictedBypassMinFeeMsgTypes = []string{testMsgTypeURL}
+				p.BypassMinFeeAddresses = []string{trusted.String()}
+			},
+			msgs:   []sdk.Msg{testdata.NewTestMsg(trusted)},
+			gas:    2_000_000,
+			expErr: true,
+		},
+		"trusted signer, unlisted msg type, zero fee": {
+			params: func(p *types.Params) {
+				p.BypassMinFeeAddresses = []string{trusted.String()}
+			},
+			msgs:   []sdk.Msg{testdata.NewTestMsg(trusted)},
+			gas:    200_000,
+			expErr: true,
+		},
+		"trusted and untrusted signers, zero fee": {
+			params: func(p *types.Params) {
+				p.AddressRestrictedBypassMinFeeMsgTypes = []string{testMsgTypeURL}
+				p.BypassMinFeeAddresses = []string{trusted.String()}
+			},
+			msgs:   []sdk.Msg{testdata.NewTestMsg(trusted), testdata.NewTestMsg(untrusted)},
+			gas:    200_000,
+			expErr: true,
+		},
+		"untrusted signer, zero fee": {
+			params: func(p *types.Params) {
+				p.BypassMinFeeAddresses = []string{trusted.String()}
+			},
+			msgs:   []sdk.Msg{testdata.NewTestMsg(untrusted)},
+			gas:    200_000,
+			expErr: true,
+		},
+		"untrusted signer, bypass msg type, zero fee": {
+			params: func(p *types.Params) {
+				p.BypassMinFeeMsgTypes = []string{testMsgTypeURL}
+			},
+			msgs: []sdk.Msg{testdata.NewTestMsg(untrusted)},
+			gas:  200_000,
+		},
+		"untrusted signer, address restricted bypass msg type, zero fee": {
+			params: func(p *types.Params) {
+				p.BypassMinFeeMsgTypes = []string{testMsgTypeURL}
+				p.AddressRestrictedBypassMinFeeMsgTypes = []string{testMsgTypeURL}
+				p.BypassMinFeeAddresses = []string{trusted.String()}
+			},
+			msgs:   []sdk.Msg{testdata.NewTestMsg(untrusted)},
+			gas:    200_000,
+			expErr: true,
+		},
+		"untrusted signer, address restricted bypass msg type, paid fee": {
+			params: func(p *types.Params) {
+				p.BypassMinFeeMsgTypes = []string{testMsgTypeURL}
+				p.AddressRestrictedBypassMinFeeMsgTypes = []string{testMsgTypeURL}
+				p.BypassMinFeeAddresses = []string{trusted.String()}
+			},
+			msgs: []sdk.Msg{testdata.NewTestMsg(untrusted)},
+			fee:  paidFee,
+			gas:  200_000,
+		},
+		"trusted signer, address restricted bypass msg type, zero fee": {
+			params: func(p *types.Params) {
+				p.BypassMinFeeMsgTypes = []string{testMsgTypeURL}
+				p.AddressRestrictedBypassMinFeeMsgTypes = []string{testMsgTypeURL}
+				p.BypassMinFeeAddresses = []string{trusted.String()}
+			},
+			msgs: []sdk.Msg{testdata.NewTestMsg(trusted)},
+			gas:  200_000,
+		},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.setParams(func(p *types.Params) {
+				p.BypassMinFeeMsgTypes = []string{}
+				test.params(p)
+			})
+			err := s.anteHandle(test.msgs, test.fee, test.gas)
+			if test.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *feeDecoratorTestSuite) TestMaxBypassMinFeeGasUsage() {
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	tests := map[string]struct {
+		params func(p *types.Params)
+		gas    uint64
+		expErr bool
+	}{
+		"gas within default limit": {
+			params: func(p *types.Params) {},
+			gas:    1_000_000,
+		},
+		"gas above default limit": {
+			params: func(p *types.Params) {},
+			gas:    1_000_001,
+			expErr: true,
+		},
+		"gas within lowered limit": {
+			params: func(p *types.Params) {
+				p.MaxTotalBypassMinFeeMsgGasUsage = 100_000
+			},
+			gas: 100_000,
+		},
+		"gas above lowered limit": {
+			params: func(p *types.Params) {
+				p.MaxTotalBypassMinFeeMsgGasUsage = 100_000
+			},
+			gas:    200_000,
+			expErr: true,
+		},
+		"gas within msg type limit": {
+			params: func(p *types.Params) {
+				p.MaxBypassMinFeeMsgTypeGasUsages = []types.BypassMinFeeMsgTypeGasUsage{
+					{MsgTypeUrl: testMsgTypeURL, MaxGasUsage: 5_000_000},
+				}
+			},
+			gas: 5_000_000,
+		},
+		"gas above msg type limit": {
+			params: func(p *types.Params) {
+				p.MaxBypassMinFeeMsgTypeGasUsages = []types.BypassMinFeeMsgTypeGasUsage{
+					{MsgTypeUrl: testMsgTypeURL, MaxGasUsage: 5_000_000},
+				}
+			},
+			gas:    5_000_001,
+			expErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.setParams(func(p *types.Params) {
+				p.BypassMinFeeMsgTypes = []string{testMsgTypeURL}
+				test.params(p)
+			})
+			err := s.anteHandle([]sdk.Msg{testdata.NewTestMsg(addr)}, nil, test.gas)
+			if test.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *feeDecoratorTestSuite) TestBaseFee() {
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	tests := map[string]struct {
+		enabled bool
+		fee     sdk.Coins
+		expErr  bool
+	}{
+		"disabled, global fee paid": {
+			fee: sdk.NewCoins(sdk.NewCoin("uusdc", sdk.NewInt(20_000))),
+		},
+		"enabled, global fee paid, below base fee": {
+			enabled: true,
+			fee:     sdk.NewCoins(sdk.NewCoin("uusdc", sdk.NewInt(20_000))),
+			expErr:  true,
+		},
+		"enabled, base fee paid": {
+			enabled: true,
+			fee:     sdk.NewCoins(sdk.NewCoin("uusdc", sdk.NewInt(40_000))),
+		},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.setParams(func(p *types.Params) {
+				p.BaseFeeEnabled = test.enabled
+			})
+			s.globalfeeKeeper.SetBaseFeeMultiplier(s.ctx, sdk.NewDec(2))
+			err := s.anteHandle([]sdk.Msg{testdata.NewTestMsg(addr)}, test.fee, 200_000)
+			if test.expErr {
+				s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *feeDecoratorTestSuite) TestEnforceGlobalFeeInDeliverTx() {
+	_, _, addr := testdata.KeyTestPubAddr()
+	paidFee := sdk.NewCoins(sdk.NewCoin("uusdc", sdk.NewInt(20_000)))
+	localMinGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uusdc", sdk.NewDec(1)))
+
+	tests := map[string]struct {
+		enforce bool
+		checkTx bool
+		msgs    []sdk.Msg
+		fee     sdk.Coins
+		expErr  bool
+	}{
+		"not enforced, deliver tx, zero fee": {
+			msgs: []sdk.Msg{testdata.NewTestMsg(addr)},
+		},
+		"enforced, deliver tx, zero fee": {
+			enforce: true,
+			msgs:    []sdk.Msg{testdata.NewTestMsg(addr)},
+			expErr:  true,
+		},
+		"enforced, deliver tx, global fee paid, below local min gas prices": {
+			enforce: true,
+			msgs:    []sdk.Msg{testdata.NewTestMsg(addr)},
+			fee:     paidFee,
+		},
+		"enforced, deliver tx, bypass msg type, zero fee": {
+			enforce: true,
+			msgs:    []sdk.Msg{&banktypes.MsgSend{FromAddress: addr.String()}},
+		},
+		"enforced, check tx, global fee paid, below local min gas prices": {
+			enforce: true,
+			checkTx: true,
+			msgs:    []sdk.Msg{testdata.NewTestMsg(addr)},
+			fee:     paidFee,
+			expErr:  true,
+		},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.setParams(func(p *types.Params) {
+				p.BypassMinFeeMsgTypes = []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+				p.EnforceGlobalFeeInDeliverTx = test.enforce
+			})
+			s.ctx = s.ctx.WithIsCheckTx(test.checkTx).WithMinGasPrices(localMinGasPrices)
+			err := s.anteHandle(test.msgs, test.fee, 200_000)
+			if test.expErr {
+				s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *feeDecoratorTestSuite) TestTxPriority() {
+	_, _, addr := testdata.KeyTestPubAddr()
+	bypassMsg := &banktypes.MsgSend{FromAddress: addr.String()}
+
+	tests := map[string]struct {
+		params      func(p *types.Params)
+		msgs        []sdk.Msg
+		fee         sdk.Coins
+		expPriority int64
+	}{
+		"exact global fee": {
+			msgs:        []sdk.Msg{testdata.NewTestMsg(addr)},
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("uusdc", 20_000)),
+			expPriority: ante.BaseTxPriority,
+		},
+		"twice the global fee": {
+			msgs:        []sdk.Msg{testdata.NewTestMsg(addr)},
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("uusdc", 40_000)),
+			expPriority: 2 * ante.BaseTxPriority,
+		},
+		"normalised across denoms, best paying denom counts": {
+			params: func(p *types.Params) {
+				p.MinimumGasPrices = sdk.NewDecCoins(
+					sdk.NewDecCoinFromDec("uatom", sdk.NewDec(1)),
+					sdk.NewDecCoinFromDec("uusdc", sdk.NewDecWithPrec(1, 1)),
+				)
+			},
+			msgs:        []sdk.Msg{testdata.NewTestMsg(addr)},
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("uatom", 300_000), sdk.NewInt64Coin("uusdc", 20_000)),
+			expPriority: 3 * ante.BaseTxPriority / 2,
+		},
+		"converted fee denom": {
+			params: func(p *types.Params) {
+				p.ReferenceDenom = "uusdc"
+				p.FeeConversionRates = sdk.NewDecCoins(sdk.NewDecCoinFromDec("ueure", sdk.NewDec(2)))
+			},
+			msgs:        []sdk.Msg{testdata.NewTestMsg(addr)},
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("ueure", 80_000)),
+			expPriority: 2 * ante.BaseTxPriority,
+		},
+		"bypass msg, default priority": {
+			msgs:        []sdk.Msg{bypassMsg},
+			expPriority: 0,
+		},
+		"bypass msg, configured priority": {
+			params: func(p *types.Params) {
+				p.BypassMinFeeTxPriority = 5 * ante.BaseTxPriority
+			},
+			msgs:        []sdk.Msg{bypassMsg},
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1)),
+			expPriority: 5 * ante.BaseTxPriority,
+		},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.setParams(func(p *types.Params) {
+				p.BypassMinFeeMsgTypes = []string{sdk.MsgTypeURL(bypassMsg)}
+				if test.params != nil {
+					test.params(p)
+				}
+			})
+			s.Require().NoError(s.anteHandle(test.msgs, test.fee, 200_000))
+
+			decorator := ante.NewFeeDecorator(s.globalfeeSubspace, s.stakingSubspace, s.globalfeeKeeper)
+			priority, err := decorator.TxPriority(s.ctx, mockFeeTx{msgs: test.msgs, fee: test.fee, gas: 200_000})
+			s.Require().NoError(err)
+			s.Require().Equal(test.expPriority, priority)
+		})
+	}
+}
+
+func (s *feeDecoratorTestSuite) TestRequiredFees() {
+	_, _, trusted := testdata.KeyTestPubAddr()
+	_, _, addr := testdata.KeyTestPubAddr()
+	bypassMsg := &banktypes.MsgSend{FromAddress: addr.String()}
+	globalFees := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 20_000))
+
+	tests := map[string]struct {
+		msgs              []sdk.Msg
+		gas               uint64
+		localMinGasPrices sdk.DecCoins
+		expBypass         bool
+		expFees           sdk.Coins
+		expReason         string
+	}{
+		"bypass msg type": {
+			msgs:      []sdk.Msg{bypassMsg},
+			gas:       200_000,
+			expBypass: true,
+			expReason: "tx contains only bypass min fee msg types",
+		},
+		"bypass msg type, gas above bypass limit": {
+			msgs:      []sdk.Msg{bypassMsg},
+			gas:       2_000_000,
+			expFees:   sdk.NewCoins(sdk.NewInt64Coin("uusdc", 200_000)),
+			expReason: "gas limit 2000000 exceeds the max bypass min fee gas usage 1000000",
+		},
+		"bypass msg type and other msg type": {
+			msgs:      []sdk.Msg{bypassMsg, testdata.NewTestMsg(addr)},
+			gas:       200_000,
+			expFees:   globalFees,
+			expReason: "tx contains msg types that cannot bypass the min fee",
+		},
+		"address restricted msg type signed only by bypass min fee address": {
+			msgs:      []sdk.Msg{testdata.NewTestMsg(trusted)},
+			gas:       200_000,
+			expBypass: true,
+			expReason: "tx contains only bypass min fee msg types and is signed only by bypass min fee addresses",
+		},
+		"address restricted msg type signed only by bypass min fee address, gas above bypass limit": {
+			msgs:      []sdk.Msg{testdata.NewTestMsg(trusted)},
+			gas:       2_000_000,
+			expFees:   sdk.NewCoins(sdk.NewInt64Coin("uusdc", 200_000)),
+			expReason: "gas limit 2000000 exceeds the max bypass min fee gas usage 1000000",
+		},
+		"higher local min gas prices": {
+			msgs:              []sdk.Msg{testdata.NewTestMsg(addr)},
+			gas:               200_000,
+			localMinGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("uusdc", sdk.NewDec(1))),
+			expFees:           sdk.NewCoins(sdk.NewInt64Coin("uusdc", 200_000)),
+			expReason:         "tx contains msg types that cannot bypass the min fee",
+		},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.setParams(func(p *types.Params) {
+				p.BypassMinFeeMsgTypes = []string{sdk.MsgTypeURL(bypassMsg)}
+				p.AddressRestrictedBypassMinFeeMsgTypes = []string{testMsgTypeURL}
+				p.BypassMinFeeAddresses = []string{trusted.String()}
+			})
+			s.ctx = s.ctx.WithMinGasPrices(test.localMinGasPrices)
+			decorator := ante.NewFeeDecorator(s.globalfeeSubspace, s.stakingSubspace, s.globalfeeKeeper)
+			res, err := decorator.RequiredFees(s.ctx, mockFeeTx{msgs: test.msgs, gas: test.gas})
+			s.Require().NoError(err)
+			s.Require().Equal(test.expBypass, res.BypassMinFee)
+			s.Require().Equal(test.expReason, res.Reason)
+			s.Require().Equal(test.expFees, res.RequiredFees)
+			s.Require().Equal("uusdc", res.RequiredGlobalFees[0].Denom)
+
+			// the decorator agrees: a bypass tx passes without fee, any other
+			// tx passes with exactly the required fees
+			err = s.anteHandle(test.msgs, res.RequiredFees, test.gas)
+			s.Require().NoError(err)
+			if !test.expBypass {
+				err = s.anteHandle(test.msgs, res.RequiredFees.Sub(sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1))), test.gas)
+				s.Require().Error(err)
+			}
+		})
+	}
+
+	s.Run("msg types, signers unknown", func() {
+		s.SetupTest()
+		s.setParams(func(p *types.Params) {
+			p.BypassMinFeeMsgTypes = []string{sdk.MsgTypeURL(bypassMsg)}
+			p.BypassMinFeeAddresses = []string{trusted.String()}
+		})
+		decorator := ante.NewFeeDecorator(s.globalfeeSubspace, s.stakingSubspace, s.globalfeeKeeper)
+		res, err := decorator.MsgTypesRequiredFees(s.ctx, []string{testMsgTypeURL}, 200_000)
+		s.Require().NoError(err)
+		s.Require().False(res.BypassMinFee)
+		s.Require().Equal(globalFees, res.RequiredFees)
+		s.Require().Equal(globalFees, res.RequiredGlobalFees)
+	})
+}
+
+func TestGetGlobalFeeTxPriority(t *testing.T) {
+	required := sdk.NewCoins(sdk.NewInt64Coin("uatom", 0), sdk.NewInt64Coin("uusdc", 100))
+
+	require.Equal(t, ante.BaseTxPriority/2, ante.GetGlobalFeeTxPriority(sdk.NewCoins(sdk.NewInt64Coin("uusdc", 50)), required))
+	// no positive global fee in the paid denom, the naive priority is used
+	require.Equal(t, int64(7), ante.GetGlobalFeeTxPriority(sdk.NewCoins(sdk.NewInt64Coin("uatom", 7)), required))
+	// capped instead of overflowing
+	huge := sdk.NewCoins(sdk.NewCoin("uusdc", sdk.NewIntFromUint64(math.MaxUint64)))
+	require.Equal(t, int64(math.MaxInt64), ante.GetGlobalFeeTxPriority(huge, required))
+}
+
+// mockFeeTx is a minimal sdk.FeeTx for exercising the fee decorator.
+type mockFeeTx struct {
+	msgs []sdk.Msg
+	fee  sdk.Coins
+	gas  uint64
+}
+
+var _ sdk.FeeTx = mockFeeTx{}
+
+func (tx mockFeeTx) GetMsgs() []sdk.Msg         { return tx.msgs }
+func (tx mockFeeTx) ValidateBasic() error       { return nil }
+func (tx mockFeeTx) GetGas() uint64             { return tx.gas }
+func (tx mockFeeTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx mockFeeTx) FeePayer() sdk.AccAddress   { return tx.msgs[0].GetSigners()[0] }
+func (tx mockFeeTx) FeeGranter() sdk.AccAddress { return nil }
@@ -7,7 +7,6 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
-	"github.com/noble-assets/noble/v5/x/globalfee/types"
 
 	"github.com/noble-assets/noble/v5/x/globalfee"
 )
@@ -16,8 +15,9 @@ import (
 // as the local validator's minimum gasFee (defined in validator config) and global fee, and the fee denom should be in the global fees' denoms.
 //
 // If fee is too low, decorator returns error and tx is rejected from mempool.
-// Note this only applies when ctx.CheckTx = true. If fee is high enough or not
-// CheckTx, then call next AnteHandler.
+// Note this only applies when ctx.CheckTx = true, unless EnforceGlobalFeeInDeliverTx
+// is set, in which case the global fee (but not the local minimum gas prices) is
+// also checked in DeliverTx. If fee is high enough or not checked, then call next AnteHandler.
 //
 // CONTRACT: Tx must implement FeeTx to use FeeDecorator
 // If the tx msg type is one of the bypass msg types, the tx is valid even if the min fee is lower than normally required.
@@ -26,12 +26,12 @@ import (
 var _ sdk.AnteDecorator = FeeDecorator{}
 
 type FeeDecorator struct {
-	GlobalMinFee                    globalfee.ParamSource
-	StakingSubspace                 paramtypes.Subspace
-	MaxTotalBypassMinFeeMsgGasUsage uint64
+	GlobalMinFee    globalfee.ParamSource
+	StakingSubspace paramtypes.Subspace
+	GlobalFeeKeeper globalfee.Keeper
 }
 
-func NewFeeDecorator(globalfeeSubspace, stakingSubspace paramtypes.Subspace, maxTotalBypassMinFeeMsgGasUsage uint64) FeeDecorator {
+func NewFeeDecorator(globalfeeSubspace, stakingSubspace paramtypes.Subspace, globalfeeKeeper globalfee.Keeper) FeeDecorator {
 	if !globalfeeSubspace.HasKeyTable() {
 		panic("global fee paramspace was not set up via module")
 	}
@@ -41,9 +41,9 @@ func NewFeeDecorator(globalfeeSubspace, stakingSubspace paramtypes.Subspace, max
 	}
 
 	return FeeDecorator{
-		GlobalMinFee:                    globalfeeSubspace,
-		StakingSubspace:                 stakingSubspace,
-		MaxTotalBypassMinFeeMsgGasUsage: maxTotalBypassMinFeeMsgGasUsage,
+		GlobalMinFee:    globalfeeSubspace,
+		StakingSubspace: stakingSubspace,
+		GlobalFeeKeeper: globalfeeKeeper,
 	}
 }
 
@@ -61,23 +61,31 @@ func (mfd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	// 	- the tx contains only message types that can bypass the minimum fee,
 	//	see BypassMinFeeMsgTypes;
 	//	- the total gas limit per message does not exceed MaxTotalBypassMinFeeMsgGasUsage,
-	//	i.e., totalGas <= MaxTotalBypassMinFeeMsgGasUsage
+	//	i.e., totalGas <= MaxTotalBypassMinFeeMsgGasUsage, or the higher limit of
+	//	its msg types, see MaxBypassMinFeeMsgTypeGasUsages
+	// or if the tx is signed only by trusted addresses, see BypassMinFeeAddresses.
 	// Otherwise, minimum fees and global fees are checked to prevent spam.
-	doesNotExceedMaxGasUsage := gas <= mfd.MaxTotalBypassMinFeeMsgGasUsage
-	allowedToBypassMinFee := mfd.containsOnlyBypassMinFeeMsgs(ctx, msgs) && doesNotExceedMaxGasUsage
+	allowedToBypassMinFee := mfd.allowedToBypassMinFee(ctx, msgs, gas)
 
 	var allFees sdk.Coins
-	requiredGlobalFees, err := mfd.getGlobalFee(ctx, feeTx)
+	requiredGlobalFees, err := mfd.getGlobalFee(ctx, msgTypeURLs(msgs), gas)
 	if err != nil {
 		panic(err)
 	}
-	requiredFees := getMinGasPrice(ctx, feeTx)
 
-	// Only check for minimum fees and global fee if the execution mode is CheckTx
-	if !ctx.IsCheckTx() || simulate {
+	// Only check for minimum fees and global fee if the execution mode is
+	// CheckTx, or the global fee is also enforced in DeliverTx.
+	if simulate || (!ctx.IsCheckTx() && !mfd.enforceGlobalFeeInDeliverTx(ctx)) {
 		return next(ctx, tx, simulate)
 	}
 
+	// The validator's local minimum gas prices are not part of consensus, so
+	// in DeliverTx only the global fee is required.
+	var requiredFees sdk.Coins
+	if ctx.IsCheckTx() {
+		requiredFees = getMinGasPrice(ctx, gas)
+	}
+
 	if !allowedToBypassMinFee {
 		// Either the transaction contains at least on message of a type
 		// that cannot bypass the minimum fee or the total gas limit exceeds
@@ -113,16 +121,46 @@ func (mfd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	return next(ctx, tx, simulate)
 }
 
+// TxPriority returns the mempool priority of a tx that passed the fee check:
+// txs that are allowed to bypass the minimum fee get BypassMinFeeTxPriority,
+// other txs are prioritised by the fee they pay relative to the global fee,
+// see GetGlobalFeeTxPriority.
+func (mfd FeeDecorator) TxPriority(ctx sdk.Context, tx sdk.Tx) (int64, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if mfd.allowedToBypassMinFee(ctx, feeTx.GetMsgs(), feeTx.GetGas()) {
+		return mfd.getBypassMinFeeTxPriority(ctx), nil
+	}
+
+	requiredGlobalFees, err := mfd.getGlobalFee(ctx, msgTypeURLs(feeTx.GetMsgs()), feeTx.GetGas())
+	if err != nil {
+		return 0, err
+	}
+
+	return GetGlobalFeeTxPriority(feeTx.GetFee().Sort(), requiredGlobalFees), nil
+}
+
+// allowedToBypassMinFee reports whether a tx with msgs and gas limit may pay no fee.
+func (mfd FeeDecorator) allowedToBypassMinFee(ctx sdk.Context, msgs []sdk.Msg, gas uint64) bool {
+	urls := msgTypeURLs(msgs)
+	doesNotExceedMaxGasUsage := gas <= mfd.getMaxBypassMinFeeGasUsage(ctx, urls)
+	return mfd.containsOnlyBypassMinFeeMsgs(ctx, urls, mfd.signedOnlyByBypassMinFeeAddresses(ctx, msgs)) && doesNotExceedMaxGasUsage
+}
+
 // ParamStoreKeyMinGasPrices type require coins sorted. getGlobalFee will also return sorted coins (might return 0denom if globalMinGasPrice is 0)
-func (mfd FeeDecorator) getGlobalFee(ctx sdk.Context, feeTx sdk.FeeTx) (sdk.Coins, error) {
+func (mfd FeeDecorator) getGlobalFee(ctx sdk.Context, msgTypeURLs []string, gas uint64) (sdk.Coins, error) {
 	var (
 		globalMinGasPrices sdk.DecCoins
 		err                error
 	)
 
-	if mfd.GlobalMinFee.Has(ctx, types.ParamStoreKeyMinGasPrices) {
-		mfd.GlobalMinFee.Get(ctx, types.ParamStoreKeyMinGasPrices, &globalMinGasPrices)
-	}
+	// use the prices of the most expensive msg type, including the prices of
+	// the converted fee denoms derived from the reference denom, scaled by
+	// the current dynamic base fee
+	globalMinGasPrices = mfd.GlobalFeeKeeper.TxMinGasPrices(ctx, msgTypeURLs)
 	// global fee is empty set, set global fee to 0uatom
 	if len(globalMinGasPrices) == 0 {
 		globalMinGasPrices, err = mfd.DefaultZeroGlobalFee(ctx)
@@ -130,7 +168,7 @@ func (mfd FeeDecorator) getGlobalFee(ctx sdk.Context, feeTx sdk.FeeTx) (sdk.Coin
 	requiredGlobalFees := make(sdk.Coins, len(globalMinGasPrices))
 	// Determine the required fees by multiplying each required minimum gas
 	// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-	glDec := sdk.NewDec(int64(feeTx.GetGas()))
+	glDec := sdk.NewDec(int64(gas))
 	for i, gp := range globalMinGasPrices {
 		fee := gp.Amount.Mul(glDec)
 		requiredGlobalFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
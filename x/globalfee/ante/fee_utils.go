@@ -2,6 +2,7 @@ package ante
 
 import (
 	"math"
+	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	globalfeetypes "github.com/noble-assets/noble/v5/x/globalfee/types"
@@ -9,9 +10,8 @@ import (
 )
 
 // getMinGasPrice will also return sorted coins
-func getMinGasPrice(ctx sdk.Context, feeTx sdk.FeeTx) sdk.Coins {
+func getMinGasPrice(ctx sdk.Context, gas uint64) sdk.Coins {
 	minGasPrices := ctx.MinGasPrices()
-	gas := feeTx.GetGas()
 	// special case: if minGasPrices=[], requiredFees=[]
 	requiredFees := make(sdk.Coins, len(minGasPrices))
 	// if not all coins are zero, check fee with min_gas_price
@@ -28,15 +28,47 @@ func getMinGasPrice(ctx sdk.Context, feeTx sdk.FeeTx) sdk.Coins {
 	return requiredFees.Sort()
 }
 
-func (mfd FeeDecorator) containsOnlyBypassMinFeeMsgs(ctx sdk.Context, msgs []sdk.Msg) bool {
+// msgTypeURLs returns the type URLs of msgs.
+func msgTypeURLs(msgs []sdk.Msg) []string {
+	urls := make([]string, len(msgs))
+	for i, msg := range msgs {
+		urls[i] = sdk.MsgTypeURL(msg)
+	}
+
+	return urls
+}
+
+// containsOnlyBypassMinFeeMsgs returns true if every msg type may bypass the
+// minimum fee. Address restricted msg types only may if the tx is signed only
+// by BypassMinFeeAddresses, see signedOnlyByBypassMinFeeAddresses.
+func (mfd FeeDecorator) containsOnlyBypassMinFeeMsgs(ctx sdk.Context, msgTypeURLs []string, signedOnlyByBypassMinFeeAddresses bool) bool {
 	var bypassMinFeeMsgTypes []string
 	if mfd.GlobalMinFee.Has(ctx, globalfeetypes.ParamStoreKeyBypassMinFeeMsgTypes) {
 		mfd.GlobalMinFee.Get(ctx, globalfeetypes.ParamStoreKeyBypassMinFeeMsgTypes, &bypassMinFeeMsgTypes)
 	} else {
 		bypassMinFeeMsgTypes = globalfeetypes.DefaultParams().BypassMinFeeMsgTypes
 	}
-	for _, msg := range msgs {
-		if tmstrings.StringInSlice(sdk.MsgTypeURL(msg), bypassMinFeeMsgTypes) {
+	var msgTypeGasPrices []globalfeetypes.MsgTypeGasPrices
+	if mfd.GlobalMinFee.Has(ctx, globalfeetypes.ParamStoreKeyMsgTypeGasPrices) {
+		mfd.GlobalMinFee.Get(ctx, globalfeetypes.ParamStoreKeyMsgTypeGasPrices, &msgTypeGasPrices)
+	}
+	var addressRestrictedMsgTypes []string
+	if mfd.GlobalMinFee.Has(ctx, globalfeetypes.ParamStoreKeyAddressRestrictedBypassMinFeeMsgTypes) {
+		mfd.GlobalMinFee.Get(ctx, globalfeetypes.ParamStoreKeyAddressRestrictedBypassMinFeeMsgTypes, &addressRestrictedMsgTypes)
+	}
+	overrides := globalfeetypes.Params{MsgTypeGasPrices: msgTypeGasPrices}
+	for _, msgTypeURL := range msgTypeURLs {
+		// msg types with their own minimum gas prices never bypass the minimum fee
+		if ok, _ := overrides.FindMsgTypeGasPrices(msgTypeURL); ok {
+			return false
+		}
+		if tmstrings.StringInSlice(msgTypeURL, addressRestrictedMsgTypes) {
+			if signedOnlyByBypassMinFeeAddresses {
+				continue
+			}
+			return false
+		}
+		if tmstrings.StringInSlice(msgTypeURL, bypassMinFeeMsgTypes) {
 			continue
 		}
 		return false
@@ -45,6 +77,63 @@ func (mfd FeeDecorator) containsOnlyBypassMinFeeMsgs(ctx sdk.Context, msgs []sdk
 	return true
 }
 
+// getBypassMinFeeTxPriority returns the fixed priority of txs that bypass the minimum fee.
+func (mfd FeeDecorator) getBypassMinFeeTxPriority(ctx sdk.Context) int64 {
+	var priority int64
+	if mfd.GlobalMinFee.Has(ctx, globalfeetypes.ParamStoreKeyBypassMinFeeTxPriority) {
+		mfd.GlobalMinFee.Get(ctx, globalfeetypes.ParamStoreKeyBypassMinFeeTxPriority, &priority)
+	}
+
+	return priority
+}
+
+// enforceGlobalFeeInDeliverTx reports whether the global fee is also checked in DeliverTx.
+func (mfd FeeDecorator) enforceGlobalFeeInDeliverTx(ctx sdk.Context) bool {
+	var enforce bool
+	if mfd.GlobalMinFee.Has(ctx, globalfeetypes.ParamStoreKeyEnforceGlobalFeeInDeliverTx) {
+		mfd.GlobalMinFee.Get(ctx, globalfeetypes.ParamStoreKeyEnforceGlobalFeeInDeliverTx, &enforce)
+	}
+
+	return enforce
+}
+
+// getMaxBypassMinFeeGasUsage returns the maximum gas limit of a bypass tx
+// containing msgs of the given types.
+func (mfd FeeDecorator) getMaxBypassMinFeeGasUsage(ctx sdk.Context, msgTypeURLs []string) uint64 {
+	var params globalfeetypes.Params
+	if mfd.GlobalMinFee.Has(ctx, globalfeetypes.ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage) {
+		mfd.GlobalMinFee.Get(ctx, globalfeetypes.ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage, &params.MaxTotalBypassMinFeeMsgGasUsage)
+	} else {
+		params.MaxTotalBypassMinFeeMsgGasUsage = globalfeetypes.DefaultMaxTotalBypassMinFeeMsgGasUsage
+	}
+	if mfd.GlobalMinFee.Has(ctx, globalfeetypes.ParamStoreKeyMaxBypassMinFeeMsgTypeGasUsages) {
+		mfd.GlobalMinFee.Get(ctx, globalfeetypes.ParamStoreKeyMaxBypassMinFeeMsgTypeGasUsages, &params.MaxBypassMinFeeMsgTypeGasUsages)
+	}
+
+	return params.MaxBypassMinFeeGasUsage(msgTypeURLs)
+}
+
+// signedOnlyByBypassMinFeeAddresses returns true if every signer of msgs is one of the BypassMinFeeAddresses.
+func (mfd FeeDecorator) signedOnlyByBypassMinFeeAddresses(ctx sdk.Context, msgs []sdk.Msg) bool {
+	var bypassMinFeeAddresses []string
+	if mfd.GlobalMinFee.Has(ctx, globalfeetypes.ParamStoreKeyBypassMinFeeAddresses) {
+		mfd.GlobalMinFee.Get(ctx, globalfeetypes.ParamStoreKeyBypassMinFeeAddresses, &bypassMinFeeAddresses)
+	}
+	if len(bypassMinFeeAddresses) == 0 || len(msgs) == 0 {
+		return false
+	}
+
+	for _, msg := range msgs {
+		for _, signer := range msg.GetSigners() {
+			if !tmstrings.StringInSlice(signer.String(), bypassMinFeeAddresses) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 // DenomsSubsetOfIncludingZero and IsAnyGTEIncludingZero are similar to DenomsSubsetOf and IsAnyGTE in sdk. Since we allow zero coins in global fee(zero coins means the chain does not want to set a global fee but still want to define the fee's denom)
 //
 // overwrite DenomsSubsetOfIncludingZero from sdk, to allow zero amt coins in superset. e.g. 1stake is DenomsSubsetOfIncludingZero 0stake. [] is the DenomsSubsetOfIncludingZero of [0stake] but not [1stake].
@@ -168,6 +257,41 @@ func GetTxPriority(fee sdk.Coins) int64 {
 	return priority
 }
 
+// BaseTxPriority is the priority of a tx that pays exactly the required global fee.
+const BaseTxPriority int64 = 1_000_000
+
+// GetGlobalFeeTxPriority returns the tx priority of fee relative to the required
+// global fees, normalised across denoms: paying exactly the required global fee in
+// any accepted denom yields BaseTxPriority, and paying more scales it linearly.
+// The best paying denom counts. If none of the fee denoms has a positive required
+// global fee, it falls back to GetTxPriority.
+func GetGlobalFeeTxPriority(fee, requiredGlobalFees sdk.Coins) int64 {
+	var (
+		priority *big.Int
+		base     = big.NewInt(BaseTxPriority)
+	)
+	for _, c := range fee {
+		found, required := Find(requiredGlobalFees, c.Denom)
+		if !found || !required.Amount.IsPositive() {
+			continue
+		}
+		p := new(big.Int).Mul(c.Amount.BigInt(), base)
+		p.Quo(p, required.Amount.BigInt())
+		if priority == nil || p.Cmp(priority) > 0 {
+			priority = p
+		}
+	}
+
+	if priority == nil {
+		return GetTxPriority(fee)
+	}
+	if !priority.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return priority.Int64()
+}
+
 // Find replaces the functionality of Coins.Find from SDK v0.46.x
 func Find(coins sdk.Coins, denom string) (bool, sdk.Coin) {
 	switch len(coins) {
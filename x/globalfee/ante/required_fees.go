@@ -0,0 +1,51 @@
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	globalfeetypes "github.com/noble-assets/noble/v5/x/globalfee/types"
+)
+
+// RequiredFees returns the fees that the FeeDecorator requires of tx in
+// CheckTx, together with the reason it may or may not bypass the minimum fee.
+func (mfd FeeDecorator) RequiredFees(ctx sdk.Context, tx sdk.FeeTx) (*globalfeetypes.QueryRequiredFeesResponse, error) {
+	msgs := tx.GetMsgs()
+	return mfd.requiredFees(ctx, msgTypeURLs(msgs), tx.GetGas(), mfd.signedOnlyByBypassMinFeeAddresses(ctx, msgs))
+}
+
+// MsgTypesRequiredFees returns the fees that the FeeDecorator requires in
+// CheckTx of a tx with msgs of the given types and gas limit. As the signers
+// of the tx are unknown, BypassMinFeeAddresses are not considered.
+func (mfd FeeDecorator) MsgTypesRequiredFees(ctx sdk.Context, msgTypeURLs []string, gas uint64) (*globalfeetypes.QueryRequiredFeesResponse, error) {
+	return mfd.requiredFees(ctx, msgTypeURLs, gas, false)
+}
+
+// requiredFees mirrors the checks of AnteHandle in CheckTx.
+func (mfd FeeDecorator) requiredFees(ctx sdk.Context, msgTypeURLs []string, gas uint64, signedOnlyByBypassMinFeeAddresses bool) (*globalfeetypes.QueryRequiredFeesResponse, error) {
+	requiredGlobalFees, err := mfd.getGlobalFee(ctx, msgTypeURLs, gas)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &globalfeetypes.QueryRequiredFeesResponse{RequiredGlobalFees: requiredGlobalFees}
+	maxBypassMinFeeGasUsage := mfd.getMaxBypassMinFeeGasUsage(ctx, msgTypeURLs)
+	switch {
+	case !mfd.containsOnlyBypassMinFeeMsgs(ctx, msgTypeURLs, signedOnlyByBypassMinFeeAddresses):
+		res.Reason = "tx contains msg types that cannot bypass the min fee"
+	case gas > maxBypassMinFeeGasUsage:
+		res.Reason = fmt.Sprintf("gas limit %d exceeds the max bypass min fee gas usage %d", gas, maxBypassMinFeeGasUsage)
+	case signedOnlyByBypassMinFeeAddresses:
+		res.BypassMinFee = true
+		res.Reason = "tx contains only bypass min fee msg types and is signed only by bypass min fee addresses"
+	default:
+		res.BypassMinFee = true
+		res.Reason = "tx contains only bypass min fee msg types"
+	}
+	if !res.BypassMinFee {
+		res.RequiredFees = CombinedFeeRequirement(requiredGlobalFees, getMinGasPrice(ctx, gas))
+	}
+
+	return res, nil
+}
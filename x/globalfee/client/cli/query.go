@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
@@ -18,6 +21,10 @@ func GetQueryCmd() *cobra.Command {
 	}
 	queryCmd.AddCommand(
 		GetCmdShowMinimumGasPrices(),
+		GetCmdShowEffectiveMinGasPrices(),
+		GetCmdShowMsgTypeMinGasPrices(),
+		GetCmdShowBaseFee(),
+		GetCmdShowRequiredFees(),
 	)
 	return queryCmd
 }
@@ -46,3 +53,136 @@ func GetCmdShowMinimumGasPrices() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetCmdShowEffectiveMinGasPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "effective-min-gas-prices",
+		Short: "query effective global minimum gas prices",
+		Long:  "Query the global minimum gas prices of every accepted fee denom, including the ones derived from fee conversion rates",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EffectiveMinGasPrices(cmd.Context(), &types.QueryEffectiveMinGasPricesRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdShowMsgTypeMinGasPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "msg-type-min-gas-prices [msg-type-url]...",
+		Short:   "query global minimum gas prices for message types",
+		Long:    "Query the global minimum gas prices required for a transaction containing the given message types",
+		Example: "nobled q globalfee msg-type-min-gas-prices /cosmos.bank.v1beta1.MsgSend /ibc.applications.transfer.v1.MsgTransfer",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MsgTypeMinGasPrices(cmd.Context(), &types.QueryMsgTypeMinGasPricesRequest{
+				MsgTypeUrls: args,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdShowBaseFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-fee",
+		Short: "query the dynamic base fee",
+		Long:  "Query the current base fee multiplier and the global minimum gas prices it scales",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BaseFee(cmd.Context(), &types.QueryBaseFeeRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+const (
+	FlagGasLimit = "gas-limit"
+	FlagTxFile   = "tx-file"
+)
+
+func GetCmdShowRequiredFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "required-fees [msg-type-url]...",
+		Short: "query the fees required of a transaction",
+		Long: `Query whether a transaction may bypass the minimum fee, and otherwise the fees it must pay.
+The transaction is either read from a JSON file, or described by its message types and gas limit.`,
+		Example: `nobled q globalfee required-fees /cosmos.bank.v1beta1.MsgSend --gas-limit 200000
+nobled q globalfee required-fees --tx-file tx.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRequiredFeesRequest{MsgTypeUrls: args}
+			txFile, _ := cmd.Flags().GetString(FlagTxFile)
+			switch {
+			case txFile != "" && len(args) > 0:
+				return fmt.Errorf("either a tx file or msg type urls can be given, not both")
+			case txFile != "":
+				bz, err := os.ReadFile(txFile)
+				if err != nil {
+					return err
+				}
+				tx, err := clientCtx.TxConfig.TxJSONDecoder()(bz)
+				if err != nil {
+					return err
+				}
+				if req.TxBytes, err = clientCtx.TxConfig.TxEncoder()(tx); err != nil {
+					return err
+				}
+			case len(args) > 0:
+				if req.GasLimit, err = cmd.Flags().GetUint64(FlagGasLimit); err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("either a tx file or msg type urls must be given")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RequiredFees(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Uint64(FlagGasLimit, 0, "Gas limit of the transaction described by the msg type urls")
+	cmd.Flags().String(FlagTxFile, "", "JSON file of the transaction, as generated with --generate-only")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -55,6 +55,86 @@ func TestValidateGenesis(t *testing.T) {
 			src:    `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"},{"denom":"ZLX", "amount":"2"}]}}`,
 			expErr: false,
 		},
+		"fee conversion rates allowed": {
+			src:    `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}],"reference_denom":"ALX","fee_conversion_rates":[{"denom":"BLX", "amount":"2"}]}}`,
+			expErr: false,
+		},
+		"fee conversion rates without reference denom not allowed": {
+			src:    `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}],"fee_conversion_rates":[{"denom":"BLX", "amount":"2"}]}}`,
+			expErr: true,
+		},
+		"reference denom without fee conversion rates not allowed": {
+			src:    `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}],"reference_denom":"ALX"}}`,
+			expErr: true,
+		},
+		"reference denom must be in minimum": {
+			src:    `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}],"reference_denom":"ZLX","fee_conversion_rates":[{"denom":"BLX", "amount":"2"}]}}`,
+			expErr: true,
+		},
+		"fee conversion rate denom listed in minimum not allowed": {
+			src:    `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"},{"denom":"BLX", "amount":"1"}],"reference_denom":"ALX","fee_conversion_rates":[{"denom":"BLX", "amount":"2"}]}}`,
+			expErr: true,
+		},
+		"msg type gas prices allowed": {
+			src:    `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}],"msg_type_gas_prices":[{"msg_type_url":"/cosmos.bank.v1beta1.MsgSend","minimum_gas_prices":[{"denom":"ALX", "amount":"2"}]}]}}`,
+			expErr: false,
+		},
+		"duplicate msg type gas prices not allowed": {
+			src:    `{"params":{"msg_type_gas_prices":[{"msg_type_url":"/cosmos.bank.v1beta1.MsgSend","minimum_gas_prices":[{"denom":"ALX", "amount":"2"}]},{"msg_type_url":"/cosmos.bank.v1beta1.MsgSend","minimum_gas_prices":[{"denom":"ALX", "amount":"3"}]}]}}`,
+			expErr: true,
+		},
+		"empty msg type gas prices not allowed": {
+			src:    `{"params":{"msg_type_gas_prices":[{"msg_type_url":"/cosmos.bank.v1beta1.MsgSend","minimum_gas_prices":[]}]}}`,
+			expErr: true,
+		},
+		"bypass min fee addresses allowed": {
+			src:    `{"params":{"bypass_min_fee_addresses":["cosmos1vfuhqctnwd0k66twtanx2e2lv9jxgujlrfy3ce"]}}`,
+			expErr: false,
+		},
+		"invalid bypass min fee address not allowed": {
+			src:    `{"params":{"bypass_min_fee_addresses":["cosmos1invalid"]}}`,
+			expErr: true,
+		},
+		"duplicate bypass min fee addresses not allowed": {
+			src:    `{"params":{"bypass_min_fee_addresses":["cosmos1vfuhqctnwd0k66twtanx2e2lv9jxgujlrfy3ce","cosmos1vfuhqctnwd0k66twtanx2e2lv9jxgujlrfy3ce"]}}`,
+			expErr: true,
+		},
+		"duplicate bypass msg type gas usages not allowed": {
+			src:    `{"params":{"max_bypass_min_fee_msg_type_gas_usages":[{"msg_type_url":"/ibc.core.channel.v1.MsgRecvPacket","max_gas_usage":"5000000"},{"msg_type_url":"/ibc.core.channel.v1.MsgRecvPacket","max_gas_usage":"1"}]}}`,
+			expErr: true,
+		},
+		"zero fee conversion rate not allowed": {
+			src:    `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}],"reference_denom":"ALX","fee_conversion_rates":[{"denom":"BLX", "amount":"0"}]}}`,
+			expErr: true,
+		},
+		"bypass min fee tx priority allowed": {
+			src:    `{"params":{"bypass_min_fee_tx_priority":"1000000"}}`,
+			expErr: false,
+		},
+		"negative bypass min fee tx priority not allowed": {
+			src:    `{"params":{"bypass_min_fee_tx_priority":"-1"}}`,
+			expErr: true,
+		},
+		"base fee allowed": {
+			src:    `{"params":{"base_fee_enabled":true,"base_fee_target_gas":"1000000","base_fee_max_change_rate":"0.125","base_fee_min_multiplier":"1","base_fee_max_multiplier":"10"},"base_fee_multiplier":"2"}`,
+			expErr: false,
+		},
+		"unset base fee params default": {
+			src:    `{"params":{"base_fee_enabled":true}}`,
+			expErr: false,
+		},
+		"zero base fee max change rate not allowed": {
+			src:    `{"params":{"base_fee_max_change_rate":"0"}}`,
+			expErr: true,
+		},
+		"base fee max multiplier below min not allowed": {
+			src:    `{"params":{"base_fee_min_multiplier":"2","base_fee_max_multiplier":"1"}}`,
+			expErr: true,
+		},
+		"zero base fee multiplier not allowed": {
+			src:    `{"params":{},"base_fee_multiplier":"0"}`,
+			expErr: true,
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
@@ -75,22 +155,70 @@ func TestInitExportGenesis(t *testing.T) {
 	}{
 		"single fee": {
 			src: `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}],"bypass_min_fee_msg_types":[]}}`,
-			exp: types.GenesisState{Params: types.Params{BypassMinFeeMsgTypes: []string{}, MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1)))}},
+			exp: exportedGenesis(func(p *types.Params) {
+				p.MinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1)))
+			}),
 		},
 		"multiple fee options": {
 			src: `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}, {"denom":"BLX", "amount":"0.001"}]}}`,
-			exp: types.GenesisState{Params: types.Params{BypassMinFeeMsgTypes: []string{}, MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1)),
-				sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(1, 3)))}},
+			exp: exportedGenesis(func(p *types.Params) {
+				p.MinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1)),
+					sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(1, 3)))
+			}),
+		},
+		"fee conversion rates": {
+			src: `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}],"reference_denom":"ALX","fee_conversion_rates":[{"denom":"BLX", "amount":"0.5"}]}}`,
+			exp: exportedGenesis(func(p *types.Params) {
+				p.MinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1)))
+				p.ReferenceDenom = "ALX"
+				p.FeeConversionRates = sdk.NewDecCoins(sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(5, 1)))
+			}),
+		},
+		"msg type gas prices": {
+			src: `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}],"msg_type_gas_prices":[{"msg_type_url":"/cosmos.bank.v1beta1.MsgSend","minimum_gas_prices":[{"denom":"ALX", "amount":"2"}]}]}}`,
+			exp: exportedGenesis(func(p *types.Params) {
+				p.MinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1)))
+				p.MsgTypeGasPrices = []types.MsgTypeGasPrices{{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(2)))}}
+			}),
+		},
+		"bypass min fee addresses": {
+			src: `{"params":{"bypass_min_fee_addresses":["cosmos1vfuhqctnwd0k66twtanx2e2lv9jxgujlrfy3ce"],"address_restricted_bypass_min_fee_msg_types":["/ibc.core.channel.v1.MsgRecvPacket"]}}`,
+			exp: exportedGenesis(func(p *types.Params) {
+				p.BypassMinFeeAddresses = []string{"cosmos1vfuhqctnwd0k66twtanx2e2lv9jxgujlrfy3ce"}
+				p.AddressRestrictedBypassMinFeeMsgTypes = []string{"/ibc.core.channel.v1.MsgRecvPacket"}
+			}),
+		},
+		"bypass gas usage": {
+			src: `{"params":{"max_total_bypass_min_fee_msg_gas_usage":"1000000","max_bypass_min_fee_msg_type_gas_usages":[{"msg_type_url":"/ibc.core.channel.v1.MsgRecvPacket","max_gas_usage":"5000000"}]}}`,
+			exp: exportedGenesis(func(p *types.Params) {
+				p.MaxTotalBypassMinFeeMsgGasUsage = 1_000_000
+				p.MaxBypassMinFeeMsgTypeGasUsages = []types.BypassMinFeeMsgTypeGasUsage{{MsgTypeUrl: "/ibc.core.channel.v1.MsgRecvPacket", MaxGasUsage: 5_000_000}}
+			}),
+		},
+		"base fee": {
+			src: `{"params":{"base_fee_enabled":true,"base_fee_target_gas":"1000000","base_fee_max_change_rate":"0.125","base_fee_min_multiplier":"1","base_fee_max_multiplier":"10"},"base_fee_multiplier":"2.5"}`,
+			exp: func() types.GenesisState {
+				genesis := exportedGenesis(func(p *types.Params) {
+					p.BaseFeeEnabled = true
+					p.BaseFeeTargetGas = 1_000_000
+					p.BaseFeeMaxChangeRate = sdk.NewDecWithPrec(125, 3)
+					p.BaseFeeMinMultiplier = sdk.OneDec()
+					p.BaseFeeMaxMultiplier = sdk.NewDec(10)
+				})
+				multiplier := sdk.NewDecWithPrec(25, 1)
+				genesis.BaseFeeMultiplier = &multiplier
+				return genesis
+			}(),
 		},
 		"no fee set": {
 			src: `{"params":{}}`,
-			exp: types.GenesisState{Params: types.Params{MinimumGasPrices: sdk.DecCoins{}, BypassMinFeeMsgTypes: []string{}}},
+			exp: exportedGenesis(func(p *types.Params) {}),
 		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
-			ctx, encCfg, subspace := setupTestStore(t)
-			m := NewAppModule(subspace)
+			ctx, encCfg, subspace, keeper := setupTestStore(t)
+			m := NewAppModule(subspace, keeper, nil, nil)
 			m.InitGenesis(ctx, encCfg.Marshaler, []byte(spec.src))
 			gotJSON := m.ExportGenesis(ctx, encCfg.Marshaler)
 			var got types.GenesisState
@@ -100,14 +228,37 @@ func TestInitExportGenesis(t *testing.T) {
 	}
 }
 
-func setupTestStore(t *testing.T) (sdk.Context, simappparams.EncodingConfig, paramstypes.Subspace) {
+// exportedGenesis returns the exported genesis of params with every unset list
+// exported as an empty list and every unset base fee param as its default,
+// modified by modify.
+func exportedGenesis(modify func(p *types.Params)) types.GenesisState {
+	params := types.Params{
+		MinimumGasPrices:                      sdk.DecCoins{},
+		BypassMinFeeMsgTypes:                  []string{},
+		FeeConversionRates:                    sdk.DecCoins{},
+		MsgTypeGasPrices:                      []types.MsgTypeGasPrices{},
+		BypassMinFeeAddresses:                 []string{},
+		AddressRestrictedBypassMinFeeMsgTypes: []string{},
+		MaxBypassMinFeeMsgTypeGasUsages:       []types.BypassMinFeeMsgTypeGasUsage{},
+		BaseFeeTargetGas:                      types.DefaultBaseFeeTargetGas,
+		BaseFeeMaxChangeRate:                  types.DefaultBaseFeeMaxChangeRate,
+		BaseFeeMinMultiplier:                  types.DefaultBaseFeeMinMultiplier,
+		BaseFeeMaxMultiplier:                  types.DefaultBaseFeeMaxMultiplier,
+	}
+	modify(&params)
+	return types.GenesisState{Params: params}
+}
+
+func setupTestStore(t *testing.T) (sdk.Context, simappparams.EncodingConfig, paramstypes.Subspace, Keeper) {
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	encCfg := simapp.MakeTestEncodingConfig()
 	keyParams := sdk.NewKVStoreKey(paramstypes.StoreKey)
 	tkeyParams := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	keyGlobalFee := sdk.NewKVStoreKey(types.StoreKey)
 	ms.MountStoreWithDB(keyParams, storetypes.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tkeyParams, storetypes.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyGlobalFee, storetypes.StoreTypeIAVL, db)
 	require.NoError(t, ms.LoadLatestVersion())
 
 	paramsKeeper := paramskeeper.NewKeeper(encCfg.Marshaler, encCfg.Amino, keyParams, tkeyParams)
@@ -118,5 +269,5 @@ func setupTestStore(t *testing.T) (sdk.Context, simappparams.EncodingConfig, par
 	}, false, log.NewNopLogger())
 
 	subspace := paramsKeeper.Subspace(ModuleName).WithKeyTable(types.ParamKeyTable())
-	return ctx, encCfg, subspace
+	return ctx, encCfg, subspace, NewKeeper(encCfg.Marshaler, keyGlobalFee, subspace)
 }
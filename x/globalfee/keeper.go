@@ -0,0 +1,106 @@
+package globalfee
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/noble-assets/noble/v5/x/globalfee/types"
+)
+
+// Keeper manages the dynamic base fee, the only state of the module besides
+// its params.
+type Keeper struct {
+	cdc         codec.BinaryCodec
+	storeKey    storetypes.StoreKey
+	paramSource ParamSource
+}
+
+// NewKeeper returns a new Keeper.
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace paramstypes.Subspace) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:         cdc,
+		storeKey:    storeKey,
+		paramSource: paramSpace,
+	}
+}
+
+// GetBaseFeeMultiplier returns the multiplier currently applied to the global
+// minimum gas prices. It is one while the dynamic base fee is disabled, and
+// the min multiplier until the first block with the base fee enabled ends.
+// Without a stored multiplier, invalid base fee params count as disabled.
+func (k Keeper) GetBaseFeeMultiplier(ctx sdk.Context) sdk.Dec {
+	return k.baseFeeMultiplier(ctx, GetParams(ctx, k.paramSource))
+}
+
+func (k Keeper) baseFeeMultiplier(ctx sdk.Context, params types.Params) sdk.Dec {
+	if !params.BaseFeeEnabled {
+		return sdk.OneDec()
+	}
+	multiplier, found := k.getStoredBaseFeeMultiplier(ctx)
+	if !found {
+		if err := params.ValidateBaseFee(); err != nil {
+			return sdk.OneDec()
+		}
+		return params.BaseFeeMinMultiplier
+	}
+
+	return multiplier
+}
+
+// SetBaseFeeMultiplier stores the base fee multiplier.
+func (k Keeper) SetBaseFeeMultiplier(ctx sdk.Context, multiplier sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BaseFeeMultiplierKey, k.cdc.MustMarshal(&sdk.DecProto{Dec: multiplier}))
+}
+
+func (k Keeper) getStoredBaseFeeMultiplier(ctx sdk.Context) (sdk.Dec, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BaseFeeMultiplierKey)
+	if bz == nil {
+		return sdk.Dec{}, false
+	}
+
+	var multiplier sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &multiplier)
+	return multiplier.Dec, true
+}
+
+// UpdateBaseFee moves the base fee multiplier after a block that used
+// gasUsed gas, see Params.NextBaseFeeMultiplier. While the dynamic base fee is
+// disabled, the stored multiplier is dropped, so it restarts from the min
+// multiplier when enabled again. The params store only validates the params
+// one by one, so the multiplier is left untouched while the base fee params
+// are inconsistent with each other.
+func (k Keeper) UpdateBaseFee(ctx sdk.Context, gasUsed uint64) {
+	params := GetParams(ctx, k.paramSource)
+	if !params.BaseFeeEnabled {
+		ctx.KVStore(k.storeKey).Delete(types.BaseFeeMultiplierKey)
+		return
+	}
+	if err := params.ValidateBaseFee(); err != nil {
+		return
+	}
+
+	multiplier := k.baseFeeMultiplier(ctx, params)
+	k.SetBaseFeeMultiplier(ctx, params.NextBaseFeeMultiplier(multiplier, gasUsed))
+}
+
+// EffectiveMinGasPrices returns the current global minimum gas prices,
+// including the ones derived from the fee conversion rates.
+func (k Keeper) EffectiveMinGasPrices(ctx sdk.Context) sdk.DecCoins {
+	params := GetParams(ctx, k.paramSource)
+	return types.ScaleMinGasPrices(params.EffectiveMinGasPrices(), k.baseFeeMultiplier(ctx, params))
+}
+
+// TxMinGasPrices returns the current global minimum gas prices required for a
+// transaction containing the given message types.
+func (k Keeper) TxMinGasPrices(ctx sdk.Context, msgTypeURLs []string) sdk.DecCoins {
+	params := GetParams(ctx, k.paramSource)
+	return types.ScaleMinGasPrices(params.TxMinGasPrices(msgTypeURLs), k.baseFeeMultiplier(ctx, params))
+}
@@ -0,0 +1,54 @@
+package globalfee
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/noble/v5/x/globalfee/types"
+)
+
+func TestUpdateBaseFee(t *testing.T) {
+	ctx, _, subspace, keeper := setupTestStore(t)
+	params := types.DefaultParams()
+	params.MinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("uusdc", sdk.NewDecWithPrec(1, 1)))
+	params.BaseFeeTargetGas = 1_000_000
+	params.BaseFeeMaxMultiplier = sdk.NewDecWithPrec(12, 1)
+	subspace.SetParamSet(ctx, &params)
+
+	// disabled, the multiplier stays at one whatever the gas used
+	keeper.UpdateBaseFee(ctx, 2_000_000)
+	require.Equal(t, sdk.OneDec(), keeper.GetBaseFeeMultiplier(ctx))
+	require.Equal(t, params.MinimumGasPrices, keeper.EffectiveMinGasPrices(ctx))
+
+	subspace.Set(ctx, types.ParamStoreKeyBaseFeeEnabled, true)
+	require.Equal(t, sdk.OneDec(), keeper.GetBaseFeeMultiplier(ctx))
+
+	keeper.UpdateBaseFee(ctx, 2_000_000)
+	require.Equal(t, sdk.NewDecWithPrec(1125, 3), keeper.GetBaseFeeMultiplier(ctx))
+	require.Equal(t,
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec("uusdc", sdk.NewDecWithPrec(1125, 4))),
+		keeper.TxMinGasPrices(ctx, []string{"/cosmos.bank.v1beta1.MsgSend"}),
+	)
+
+	keeper.UpdateBaseFee(ctx, 2_000_000)
+	require.Equal(t, sdk.NewDecWithPrec(12, 1), keeper.GetBaseFeeMultiplier(ctx))
+
+	keeper.UpdateBaseFee(ctx, 0)
+	require.Equal(t, sdk.NewDecWithPrec(105, 2), keeper.GetBaseFeeMultiplier(ctx))
+
+	// inconsistent params, which the params store accepts one by one, leave
+	// the multiplier as is
+	subspace.Set(ctx, types.ParamStoreKeyBaseFeeMinMultiplier, sdk.NewDecWithPrec(15, 1))
+	keeper.UpdateBaseFee(ctx, 2_000_000)
+	require.Equal(t, sdk.NewDecWithPrec(105, 2), keeper.GetBaseFeeMultiplier(ctx))
+	subspace.Set(ctx, types.ParamStoreKeyBaseFeeMinMultiplier, sdk.OneDec())
+
+	// disabling drops the multiplier, so the base fee restarts from the min
+	// multiplier once enabled again
+	subspace.Set(ctx, types.ParamStoreKeyBaseFeeEnabled, false)
+	keeper.UpdateBaseFee(ctx, 0)
+	subspace.Set(ctx, types.ParamStoreKeyBaseFeeEnabled, true)
+	require.Equal(t, sdk.OneDec(), keeper.GetBaseFeeMultiplier(ctx))
+}
@@ -0,0 +1,84 @@
+package globalfee
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/noble-assets/noble/v5/x/globalfee/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	paramSpace paramstypes.Subspace
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(paramSpace paramstypes.Subspace) Migrator {
+	return Migrator{paramSpace: paramSpace}
+}
+
+// Migrate1to2 sets the fee conversion parameters to their defaults.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	m.setIfMissing(ctx, types.ParamStoreKeyReferenceDenom, &defaults.ReferenceDenom)
+	m.setIfMissing(ctx, types.ParamStoreKeyFeeConversionRates, &defaults.FeeConversionRates)
+	return nil
+}
+
+// Migrate2to3 sets the msg type gas prices parameter to its default.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	m.setIfMissing(ctx, types.ParamStoreKeyMsgTypeGasPrices, &defaults.MsgTypeGasPrices)
+	return nil
+}
+
+// Migrate3to4 sets the address based bypass parameters to their defaults.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	m.setIfMissing(ctx, types.ParamStoreKeyBypassMinFeeAddresses, &defaults.BypassMinFeeAddresses)
+	m.setIfMissing(ctx, types.ParamStoreKeyAddressRestrictedBypassMinFeeMsgTypes, &defaults.AddressRestrictedBypassMinFeeMsgTypes)
+	return nil
+}
+
+// Migrate4to5 sets the bypass gas usage parameters to their defaults, which
+// match the previously hardcoded limit.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	m.setIfMissing(ctx, types.ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage, &defaults.MaxTotalBypassMinFeeMsgGasUsage)
+	m.setIfMissing(ctx, types.ParamStoreKeyMaxBypassMinFeeMsgTypeGasUsages, &defaults.MaxBypassMinFeeMsgTypeGasUsages)
+	return nil
+}
+
+// Migrate5to6 sets the DeliverTx enforcement parameter to its default, which
+// keeps the global fee a CheckTx only check.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	m.setIfMissing(ctx, types.ParamStoreKeyEnforceGlobalFeeInDeliverTx, &defaults.EnforceGlobalFeeInDeliverTx)
+	return nil
+}
+
+// Migrate6to7 sets the bypass min fee tx priority parameter to its default.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	m.setIfMissing(ctx, types.ParamStoreKeyBypassMinFeeTxPriority, &defaults.BypassMinFeeTxPriority)
+	return nil
+}
+
+// Migrate7to8 sets the dynamic base fee parameters to their defaults, which
+// leave the base fee disabled.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	m.setIfMissing(ctx, types.ParamStoreKeyBaseFeeEnabled, &defaults.BaseFeeEnabled)
+	m.setIfMissing(ctx, types.ParamStoreKeyBaseFeeTargetGas, &defaults.BaseFeeTargetGas)
+	m.setIfMissing(ctx, types.ParamStoreKeyBaseFeeMaxChangeRate, &defaults.BaseFeeMaxChangeRate)
+	m.setIfMissing(ctx, types.ParamStoreKeyBaseFeeMinMultiplier, &defaults.BaseFeeMinMultiplier)
+	m.setIfMissing(ctx, types.ParamStoreKeyBaseFeeMaxMultiplier, &defaults.BaseFeeMaxMultiplier)
+	return nil
+}
+
+// setIfMissing stores value under key unless the key is already set.
+func (m Migrator) setIfMissing(ctx sdk.Context, key []byte, value interface{}) {
+	if !m.paramSpace.Has(ctx, key) {
+		m.paramSpace.Set(ctx, key, value)
+	}
+}
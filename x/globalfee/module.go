@@ -3,6 +3,7 @@ package globalfee
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -45,9 +46,12 @@ func (a AppModuleBasic) ValidateGenesis(marshaler codec.JSONCodec, config client
 	if err != nil {
 		return err
 	}
-	if err := data.Params.ValidateBasic(); err != nil {
+	if err := data.Params.WithBaseFeeDefaults().ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "params")
 	}
+	if data.BaseFeeMultiplier != nil && !data.BaseFeeMultiplier.IsPositive() {
+		return fmt.Errorf("base fee multiplier must be positive: %s", data.BaseFeeMultiplier)
+	}
 	return nil
 }
 
@@ -75,27 +79,38 @@ func (a AppModuleBasic) RegisterLegacyAminoCodec(amino *codec.LegacyAmino) {
 type AppModule struct {
 	AppModuleBasic
 	paramSpace paramstypes.Subspace
+	keeper     Keeper
+	txDecoder  sdk.TxDecoder
+	feeChecker FeeChecker
 }
 
-// NewAppModule constructor
-func NewAppModule(paramSpace paramstypes.Subspace) *AppModule {
+// NewAppModule constructor. The tx decoder and fee checker serve the
+// RequiredFees query, which is unimplemented if either is nil.
+func NewAppModule(paramSpace paramstypes.Subspace, keeper Keeper, txDecoder sdk.TxDecoder, feeChecker FeeChecker) *AppModule {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
-	return &AppModule{paramSpace: paramSpace}
+	return &AppModule{paramSpace: paramSpace, keeper: keeper, txDecoder: txDecoder, feeChecker: feeChecker}
 }
 
 func (a AppModule) InitGenesis(ctx sdk.Context, marshaler codec.JSONCodec, message json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState types.GenesisState
 	marshaler.MustUnmarshalJSON(message, &genesisState)
-	a.paramSpace.SetParamSet(ctx, &genesisState.Params)
+	params := genesisState.Params.WithBaseFeeDefaults()
+	a.paramSpace.SetParamSet(ctx, &params)
+	if genesisState.BaseFeeMultiplier != nil {
+		a.keeper.SetBaseFeeMultiplier(ctx, *genesisState.BaseFeeMultiplier)
+	}
 	return nil
 }
 
 func (a AppModule) ExportGenesis(ctx sdk.Context, marshaler codec.JSONCodec) json.RawMessage {
 	var genState types.GenesisState
 	a.paramSpace.GetParamSet(ctx, &genState.Params)
+	if multiplier, found := a.keeper.getStoredBaseFeeMultiplier(ctx); found {
+		genState.BaseFeeMultiplier = &multiplier
+	}
 	return marshaler.MustMarshalJSON(&genState)
 }
 
@@ -115,13 +130,38 @@ func (a AppModule) LegacyQuerierHandler(amino *codec.LegacyAmino) sdk.Querier {
 }
 
 func (a AppModule) RegisterServices(cfg module.Configurator) {
-	types.RegisterQueryServer(cfg.QueryServer(), NewGrpcQuerier(a.paramSpace))
+	types.RegisterQueryServer(cfg.QueryServer(), NewGrpcQuerier(a.keeper, a.txDecoder, a.feeChecker))
+
+	m := NewMigrator(a.paramSpace)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 5 to 6: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 6 to 7: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 7, m.Migrate7to8); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 7 to 8: %v", types.ModuleName, err))
+	}
 }
 
 func (a AppModule) BeginBlock(context sdk.Context, block abci.RequestBeginBlock) {
 }
 
-func (a AppModule) EndBlock(context sdk.Context, block abci.RequestEndBlock) []abci.ValidatorUpdate {
+// EndBlock moves the dynamic base fee according to the gas used by the block.
+func (a AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	a.keeper.UpdateBaseFee(ctx, ctx.BlockGasMeter().GasConsumed())
 	return nil
 }
 
@@ -130,5 +170,5 @@ func (a AppModule) EndBlock(context sdk.Context, block abci.RequestEndBlock) []a
 // introduced by the module. To avoid wrong/empty versions, the initial version
 // should be set to 1.
 func (a AppModule) ConsensusVersion() uint64 {
-	return 1
+	return 8
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/noble-assets/noble/v5/x/globalfee/types"
 )
@@ -16,31 +18,146 @@ type ParamSource interface {
 	Has(ctx sdk.Context, key []byte) bool
 }
 
+// FeeChecker computes the fees required by the fee ante handler, see
+// ante.FeeDecorator.
+type FeeChecker interface {
+	RequiredFees(ctx sdk.Context, tx sdk.FeeTx) (*types.QueryRequiredFeesResponse, error)
+	MsgTypesRequiredFees(ctx sdk.Context, msgTypeURLs []string, gas uint64) (*types.QueryRequiredFeesResponse, error)
+}
+
 type GrpcQuerier struct {
-	paramSource ParamSource
+	keeper     Keeper
+	txDecoder  sdk.TxDecoder
+	feeChecker FeeChecker
 }
 
-func NewGrpcQuerier(paramSource ParamSource) GrpcQuerier {
-	return GrpcQuerier{paramSource: paramSource}
+func NewGrpcQuerier(keeper Keeper, txDecoder sdk.TxDecoder, feeChecker FeeChecker) GrpcQuerier {
+	return GrpcQuerier{keeper: keeper, txDecoder: txDecoder, feeChecker: feeChecker}
 }
 
 // Params returns the total set of global fee parameters.
 func (g GrpcQuerier) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
-	var (
-		minGasPrices         sdk.DecCoins
-		bypassMinFeeMsgTypes []string
-	)
 	ctx := sdk.UnwrapSDKContext(stdCtx)
-	if g.paramSource.Has(ctx, types.ParamStoreKeyMinGasPrices) {
-		g.paramSource.Get(ctx, types.ParamStoreKeyMinGasPrices, &minGasPrices)
-	}
-	if g.paramSource.Has(ctx, types.ParamStoreKeyBypassMinFeeMsgTypes) {
-		g.paramSource.Get(ctx, types.ParamStoreKeyBypassMinFeeMsgTypes, &bypassMinFeeMsgTypes)
-	}
 	return &types.QueryParamsResponse{
-		Params: types.Params{
-			MinimumGasPrices:     minGasPrices,
-			BypassMinFeeMsgTypes: bypassMinFeeMsgTypes,
-		},
+		Params: GetParams(ctx, g.keeper.paramSource),
+	}, nil
+}
+
+// EffectiveMinGasPrices returns the current global minimum gas prices,
+// including the ones derived from the fee conversion rates.
+func (g GrpcQuerier) EffectiveMinGasPrices(stdCtx context.Context, _ *types.QueryEffectiveMinGasPricesRequest) (*types.QueryEffectiveMinGasPricesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	return &types.QueryEffectiveMinGasPricesResponse{
+		MinimumGasPrices: g.keeper.EffectiveMinGasPrices(ctx),
+	}, nil
+}
+
+// MsgTypeMinGasPrices returns the current global minimum gas prices required for a
+// transaction containing the given message types.
+func (g GrpcQuerier) MsgTypeMinGasPrices(stdCtx context.Context, req *types.QueryMsgTypeMinGasPricesRequest) (*types.QueryMsgTypeMinGasPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	return &types.QueryMsgTypeMinGasPricesResponse{
+		MinimumGasPrices: g.keeper.TxMinGasPrices(ctx, req.MsgTypeUrls),
 	}, nil
 }
+
+// BaseFee returns the current dynamic base fee.
+func (g GrpcQuerier) BaseFee(stdCtx context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	return &types.QueryBaseFeeResponse{
+		Enabled:          GetParams(ctx, g.keeper.paramSource).BaseFeeEnabled,
+		Multiplier:       g.keeper.GetBaseFeeMultiplier(ctx),
+		MinimumGasPrices: g.keeper.EffectiveMinGasPrices(ctx),
+	}, nil
+}
+
+// RequiredFees returns the fees required of a transaction, given either
+// encoded or as message types and a gas limit.
+func (g GrpcQuerier) RequiredFees(stdCtx context.Context, req *types.QueryRequiredFeesRequest) (*types.QueryRequiredFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if g.feeChecker == nil || g.txDecoder == nil {
+		return nil, status.Error(codes.Unimplemented, "required fees are not supported by this node")
+	}
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	switch {
+	case len(req.TxBytes) > 0 && len(req.MsgTypeUrls) > 0:
+		return nil, status.Error(codes.InvalidArgument, "only one of tx bytes and msg type urls can be set")
+	case len(req.TxBytes) > 0:
+		tx, err := g.txDecoder(req.TxBytes)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "tx must be a fee tx")
+		}
+		return g.feeChecker.RequiredFees(ctx, feeTx)
+	case len(req.MsgTypeUrls) > 0:
+		return g.feeChecker.MsgTypesRequiredFees(ctx, req.MsgTypeUrls, req.GasLimit)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either tx bytes or msg type urls must be set")
+	}
+}
+
+// GetParams reads the global fee parameters from paramSource, leaving the
+// ones that are not set empty.
+func GetParams(ctx sdk.Context, paramSource ParamSource) types.Params {
+	var params types.Params
+	if paramSource.Has(ctx, types.ParamStoreKeyMinGasPrices) {
+		paramSource.Get(ctx, types.ParamStoreKeyMinGasPrices, &params.MinimumGasPrices)
+	}
+	if paramSource.Has(ctx, types.ParamStoreKeyBypassMinFeeMsgTypes) {
+		paramSource.Get(ctx, types.ParamStoreKeyBypassMinFeeMsgTypes, &params.BypassMinFeeMsgTypes)
+	}
+	if paramSource.Has(ctx, types.ParamStoreKeyReferenceDenom) {
+		paramSource.Get(ctx, types.ParamStoreKeyReferenceDenom, &params.ReferenceDenom)
+	}
+	if paramSource.Has(ctx, types.ParamStoreKeyFeeConversionRates) {
+		paramSource.Get(ctx, types.ParamStoreKeyFeeConversionRates, &params.FeeConversionRates)
+	}
+	if paramSource.Has(ctx, types.ParamStoreKeyMsgTypeGasPrices) {
+		paramSource.Get(ctx, types.ParamStoreKeyMsgTypeGasPrices, &params.MsgTypeGasPrices)
+	}
+	if paramSource.Has(ctx, types.ParamStoreKeyBypassMinFeeAddresses) {
+		paramSource.Get(ctx, types.ParamStoreKeyBypassMinFeeAddresses, &params.BypassMinFeeAddresses)
+	}
+	if paramSource.Has(ctx, types.ParamStoreKeyAddressRestrictedBypassMinFeeMsgTypes) {
+		paramSource.Get(ctx, types.ParamStoreKeyAddressRestrictedBypassMinFeeMsgTypes, &params.AddressRestrictedBypassMinFeeMsgTypes)
+	}
+	if paramSource.Has(ctx, types.ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage) {
+		paramSource.Get(ctx, types.ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage, &params.MaxTotalBypassMinFeeMsgGasUsage)
+	}
+	if paramSource.Has(ctx, types.ParamStoreKeyMaxBypassMinFeeMsgTypeGasUsages) {
+		paramSource.Get(ctx, types.ParamStoreKeyMaxBypassMinFeeMsgTypeGasUsages, &params.MaxBypassMinFeeMsgTypeGasUsages)
+	}
+	if paramSource.Has(ctx, types.ParamStoreKeyEnforceGlobalFeeInDeliverTx) {
+		paramSource.Get(ctx, types.ParamStoreKeyEnforceGlobalFeeInDeliverTx, &params.EnforceGlobalFeeInDeliverTx)
+	}
+	if paramSource.Has(ctx, types.ParamStoreKeyBypassMinFeeTxPriority) {
+		paramSource.Get(ctx, types.ParamStoreKeyBypassMinFeeTxPriority, &params.BypassMinFeeTxPriority)
+	}
+	if paramSource.Has(ctx, types.ParamStoreKeyBaseFeeEnabled) {
+		paramSource.Get(ctx, types.ParamStoreKeyBaseFeeEnabled, &params.BaseFeeEnabled)
+	}
+	if paramSource.Has(ctx, types.ParamStoreKeyBaseFeeTargetGas) {
+		paramSource.Get(ctx, types.ParamStoreKeyBaseFeeTargetGas, &params.BaseFeeTargetGas)
+	}
+	if paramSource.Has(ctx, types.ParamStoreKeyBaseFeeMaxChangeRate) {
+		paramSource.Get(ctx, types.ParamStoreKeyBaseFeeMaxChangeRate, &params.BaseFeeMaxChangeRate)
+	}
+	if paramSource.Has(ctx, types.ParamStoreKeyBaseFeeMinMultiplier) {
+		paramSource.Get(ctx, types.ParamStoreKeyBaseFeeMinMultiplier, &params.BaseFeeMinMultiplier)
+	}
+	if paramSource.Has(ctx, types.ParamStoreKeyBaseFeeMaxMultiplier) {
+		paramSource.Get(ctx, types.ParamStoreKeyBaseFeeMaxMultiplier, &params.BaseFeeMaxMultiplier)
+	}
+
+	return params
+}
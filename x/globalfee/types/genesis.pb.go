@@ -29,6 +29,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// Params of this module
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// BaseFeeMultiplier is the current dynamic base fee multiplier, if any.
+	BaseFeeMultiplier *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_fee_multiplier,json=baseFeeMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee_multiplier,omitempty" yaml:"base_fee_multiplier"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,6 +82,54 @@ type Params struct {
 	// https://docs.cosmos.network/main/modules/auth#concepts
 	MinimumGasPrices     github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices,omitempty" yaml:"minimum_gas_prices"`
 	BypassMinFeeMsgTypes []string                                    `protobuf:"bytes,2,rep,name=bypass_min_fee_msg_types,json=bypassMinFeeMsgTypes,proto3" json:"bypass_min_fee_msg_types,omitempty" yaml:"bypass_min_fee_msg_types"`
+	// ReferenceDenom is the denom, listed in MinimumGasPrices, that the fee
+	// conversion rates are expressed against.
+	ReferenceDenom string `protobuf:"bytes,3,opt,name=reference_denom,json=referenceDenom,proto3" json:"reference_denom,omitempty" yaml:"reference_denom"`
+	// FeeConversionRates lists additional accepted fee denoms together with the
+	// amount of that denom that is worth one unit of ReferenceDenom. Their
+	// minimum gas prices are derived from the reference denom's minimum gas
+	// price. The list must be sorted by denoms asc, and rates must be positive.
+	FeeConversionRates github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=fee_conversion_rates,json=feeConversionRates,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fee_conversion_rates,omitempty" yaml:"fee_conversion_rates"`
+	// MsgTypeGasPrices overrides the minimum gas prices for specific message
+	// types. A transaction must pay the prices of its most expensive message.
+	MsgTypeGasPrices []MsgTypeGasPrices `protobuf:"bytes,5,rep,name=msg_type_gas_prices,json=msgTypeGasPrices,proto3" json:"msg_type_gas_prices,omitempty" yaml:"msg_type_gas_prices"`
+	// BypassMinFeeAddresses are trusted signers, e.g. relayers or minters, whose
+	// transactions are exempt from the minimum fee if they contain only message
+	// types of BypassMinFeeMsgTypes or AddressRestrictedBypassMinFeeMsgTypes.
+	BypassMinFeeAddresses []string `protobuf:"bytes,6,rep,name=bypass_min_fee_addresses,json=bypassMinFeeAddresses,proto3" json:"bypass_min_fee_addresses,omitempty" yaml:"bypass_min_fee_addresses"`
+	// AddressRestrictedBypassMinFeeMsgTypes are message types that only bypass
+	// the minimum fee when every signer is one of the BypassMinFeeAddresses,
+	// even if they are listed in BypassMinFeeMsgTypes.
+	AddressRestrictedBypassMinFeeMsgTypes []string `protobuf:"bytes,7,rep,name=address_restricted_bypass_min_fee_msg_types,json=addressRestrictedBypassMinFeeMsgTypes,proto3" json:"address_restricted_bypass_min_fee_msg_types,omitempty" yaml:"address_restricted_bypass_min_fee_msg_types"`
+	// MaxTotalBypassMinFeeMsgGasUsage is the maximum gas limit of a transaction
+	// that contains only bypass message types and pays no fee.
+	MaxTotalBypassMinFeeMsgGasUsage uint64 `protobuf:"varint,8,opt,name=max_total_bypass_min_fee_msg_gas_usage,json=maxTotalBypassMinFeeMsgGasUsage,proto3" json:"max_total_bypass_min_fee_msg_gas_usage,omitempty" yaml:"max_total_bypass_min_fee_msg_gas_usage"`
+	// MaxBypassMinFeeMsgTypeGasUsages overrides MaxTotalBypassMinFeeMsgGasUsage
+	// for transactions containing specific bypass message types.
+	MaxBypassMinFeeMsgTypeGasUsages []BypassMinFeeMsgTypeGasUsage `protobuf:"bytes,9,rep,name=max_bypass_min_fee_msg_type_gas_usages,json=maxBypassMinFeeMsgTypeGasUsages,proto3" json:"max_bypass_min_fee_msg_type_gas_usages,omitempty" yaml:"max_bypass_min_fee_msg_type_gas_usages"`
+	// EnforceGlobalFeeInDeliverTx makes the global minimum fee a consensus rule
+	// by also checking it when transactions are executed in a block, so that a
+	// proposer cannot include transactions that bypass it. Node-local minimum
+	// gas prices are only ever checked in CheckTx.
+	EnforceGlobalFeeInDeliverTx bool `protobuf:"varint,10,opt,name=enforce_global_fee_in_deliver_tx,json=enforceGlobalFeeInDeliverTx,proto3" json:"enforce_global_fee_in_deliver_tx,omitempty" yaml:"enforce_global_fee_in_deliver_tx"`
+	// BypassMinFeeTxPriority is the fixed mempool priority of transactions that
+	// are allowed to bypass the minimum fee. Other transactions are prioritised
+	// by the fee they pay relative to the global fee. Must not be negative.
+	BypassMinFeeTxPriority int64 `protobuf:"varint,11,opt,name=bypass_min_fee_tx_priority,json=bypassMinFeeTxPriority,proto3" json:"bypass_min_fee_tx_priority,omitempty" yaml:"bypass_min_fee_tx_priority"`
+	// BaseFeeEnabled turns on the dynamic base fee: every block, the global
+	// minimum gas prices are scaled by a multiplier that moves towards keeping
+	// the gas used per block at BaseFeeTargetGas.
+	BaseFeeEnabled bool `protobuf:"varint,12,opt,name=base_fee_enabled,json=baseFeeEnabled,proto3" json:"base_fee_enabled,omitempty" yaml:"base_fee_enabled"`
+	// BaseFeeTargetGas is the gas used per block at which the base fee stays
+	// the same.
+	BaseFeeTargetGas uint64 `protobuf:"varint,13,opt,name=base_fee_target_gas,json=baseFeeTargetGas,proto3" json:"base_fee_target_gas,omitempty" yaml:"base_fee_target_gas"`
+	// BaseFeeMaxChangeRate is the maximum relative change of the base fee per
+	// block, reached when a block uses none or twice the target gas.
+	BaseFeeMaxChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=base_fee_max_change_rate,json=baseFeeMaxChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee_max_change_rate,omitempty" yaml:"base_fee_max_change_rate"`
+	// BaseFeeMinMultiplier is the lower bound of the base fee multiplier.
+	BaseFeeMinMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=base_fee_min_multiplier,json=baseFeeMinMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee_min_multiplier,omitempty" yaml:"base_fee_min_multiplier"`
+	// BaseFeeMaxMultiplier is the upper bound of the base fee multiplier.
+	BaseFeeMaxMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=base_fee_max_multiplier,json=baseFeeMaxMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee_max_multiplier,omitempty" yaml:"base_fee_max_multiplier"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -129,40 +179,275 @@ func (m *Params) GetBypassMinFeeMsgTypes() []string {
 	return nil
 }
 
+func (m *Params) GetReferenceDenom() string {
+	if m != nil {
+		return m.ReferenceDenom
+	}
+	return ""
+}
+
+func (m *Params) GetFeeConversionRates() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.FeeConversionRates
+	}
+	return nil
+}
+
+func (m *Params) GetMsgTypeGasPrices() []MsgTypeGasPrices {
+	if m != nil {
+		return m.MsgTypeGasPrices
+	}
+	return nil
+}
+
+func (m *Params) GetBypassMinFeeAddresses() []string {
+	if m != nil {
+		return m.BypassMinFeeAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetAddressRestrictedBypassMinFeeMsgTypes() []string {
+	if m != nil {
+		return m.AddressRestrictedBypassMinFeeMsgTypes
+	}
+	return nil
+}
+
+func (m *Params) GetMaxTotalBypassMinFeeMsgGasUsage() uint64 {
+	if m != nil {
+		return m.MaxTotalBypassMinFeeMsgGasUsage
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBypassMinFeeMsgTypeGasUsages() []BypassMinFeeMsgTypeGasUsage {
+	if m != nil {
+		return m.MaxBypassMinFeeMsgTypeGasUsages
+	}
+	return nil
+}
+
+func (m *Params) GetEnforceGlobalFeeInDeliverTx() bool {
+	if m != nil {
+		return m.EnforceGlobalFeeInDeliverTx
+	}
+	return false
+}
+
+func (m *Params) GetBypassMinFeeTxPriority() int64 {
+	if m != nil {
+		return m.BypassMinFeeTxPriority
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFeeEnabled() bool {
+	if m != nil {
+		return m.BaseFeeEnabled
+	}
+	return false
+}
+
+func (m *Params) GetBaseFeeTargetGas() uint64 {
+	if m != nil {
+		return m.BaseFeeTargetGas
+	}
+	return 0
+}
+
+// MsgTypeGasPrices defines the minimum gas prices for a single message type.
+type MsgTypeGasPrices struct {
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// MinimumGasPrices replaces the global minimum gas prices for the message
+	// type. The list must be sorted by denoms asc. No duplicate denoms or
+	// negative amounts allowed.
+	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices" yaml:"minimum_gas_prices"`
+}
+
+func (m *MsgTypeGasPrices) Reset()         { *m = MsgTypeGasPrices{} }
+func (m *MsgTypeGasPrices) String() string { return proto.CompactTextString(m) }
+func (*MsgTypeGasPrices) ProtoMessage()    {}
+func (*MsgTypeGasPrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_735b05141d90e180, []int{2}
+}
+func (m *MsgTypeGasPrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTypeGasPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTypeGasPrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTypeGasPrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTypeGasPrices.Merge(m, src)
+}
+func (m *MsgTypeGasPrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTypeGasPrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTypeGasPrices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTypeGasPrices proto.InternalMessageInfo
+
+func (m *MsgTypeGasPrices) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgTypeGasPrices) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinimumGasPrices
+	}
+	return nil
+}
+
+// BypassMinFeeMsgTypeGasUsage defines the maximum gas limit of a bypass
+// transaction containing a single message type.
+type BypassMinFeeMsgTypeGasUsage struct {
+	MsgTypeUrl  string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	MaxGasUsage uint64 `protobuf:"varint,2,opt,name=max_gas_usage,json=maxGasUsage,proto3" json:"max_gas_usage,omitempty" yaml:"max_gas_usage"`
+}
+
+func (m *BypassMinFeeMsgTypeGasUsage) Reset()         { *m = BypassMinFeeMsgTypeGasUsage{} }
+func (m *BypassMinFeeMsgTypeGasUsage) String() string { return proto.CompactTextString(m) }
+func (*BypassMinFeeMsgTypeGasUsage) ProtoMessage()    {}
+func (*BypassMinFeeMsgTypeGasUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_735b05141d90e180, []int{3}
+}
+func (m *BypassMinFeeMsgTypeGasUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BypassMinFeeMsgTypeGasUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BypassMinFeeMsgTypeGasUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BypassMinFeeMsgTypeGasUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BypassMinFeeMsgTypeGasUsage.Merge(m, src)
+}
+func (m *BypassMinFeeMsgTypeGasUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *BypassMinFeeMsgTypeGasUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_BypassMinFeeMsgTypeGasUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BypassMinFeeMsgTypeGasUsage proto.InternalMessageInfo
+
+func (m *BypassMinFeeMsgTypeGasUsage) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *BypassMinFeeMsgTypeGasUsage) GetMaxGasUsage() uint64 {
+	if m != nil {
+		return m.MaxGasUsage
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "noble.globalfee.GenesisState")
 	proto.RegisterType((*Params)(nil), "noble.globalfee.Params")
+	proto.RegisterType((*MsgTypeGasPrices)(nil), "noble.globalfee.MsgTypeGasPrices")
+	proto.RegisterType((*BypassMinFeeMsgTypeGasUsage)(nil), "noble.globalfee.BypassMinFeeMsgTypeGasUsage")
 }
 
 func init() { proto.RegisterFile("globalfee/genesis.proto", fileDescriptor_735b05141d90e180) }
 
 var fileDescriptor_735b05141d90e180 = []byte{
-	// 396 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x4f, 0x8b, 0xd3, 0x40,
-	0x14, 0x4f, 0x76, 0xa1, 0x60, 0x56, 0x70, 0x09, 0x0b, 0x1b, 0x97, 0x25, 0x29, 0x39, 0x15, 0xb4,
-	0x33, 0xb4, 0xe2, 0xc5, 0x63, 0x14, 0x4b, 0xc1, 0x42, 0xa9, 0x5e, 0xf4, 0x12, 0x26, 0xf1, 0x75,
-	0x1c, 0xcc, 0x64, 0x42, 0xdf, 0xb4, 0x98, 0xa3, 0xdf, 0xc0, 0xcf, 0xe1, 0x67, 0xf0, 0x03, 0x14,
-	0xbc, 0xf4, 0xe8, 0x29, 0x4a, 0x7b, 0xeb, 0xd1, 0x4f, 0x20, 0xc9, 0x44, 0xab, 0x96, 0x9e, 0xf2,
-	0xf2, 0xde, 0xef, 0xdf, 0xcc, 0x1b, 0xe7, 0x9a, 0x67, 0x2a, 0x61, 0xd9, 0x1c, 0x80, 0x72, 0xc8,
-	0x01, 0x05, 0x92, 0x62, 0xa1, 0xb4, 0x72, 0xef, 0xe5, 0x2a, 0xc9, 0x80, 0xfc, 0x19, 0xdf, 0xf8,
-	0xa9, 0x42, 0xa9, 0x90, 0x26, 0x0c, 0x81, 0xae, 0x06, 0x09, 0x68, 0x36, 0xa0, 0xa9, 0x12, 0xb9,
-	0x21, 0xdc, 0x5c, 0x71, 0xc5, 0x55, 0x53, 0xd2, 0xba, 0x32, 0xdd, 0xf0, 0xb5, 0x73, 0x77, 0x64,
-	0x74, 0x5f, 0x6a, 0xa6, 0xc1, 0x1d, 0x3b, 0x9d, 0x82, 0x2d, 0x98, 0x44, 0xcf, 0xee, 0xda, 0xbd,
-	0x8b, 0xe1, 0x35, 0xf9, 0xcf, 0x87, 0x4c, 0x9b, 0x71, 0xe4, 0xad, 0xab, 0xc0, 0xda, 0x57, 0xc1,
-	0xa5, 0x81, 0x3f, 0x54, 0x52, 0x68, 0x90, 0x85, 0x2e, 0x67, 0xad, 0x40, 0xf8, 0xf5, 0xcc, 0xe9,
-	0x18, 0xb0, 0xfb, 0xc5, 0x76, 0x5c, 0x29, 0x72, 0x21, 0x97, 0x32, 0xe6, 0x0c, 0xe3, 0x62, 0x21,
-	0x52, 0xa8, 0x2d, 0xce, 0x7b, 0x17, 0xc3, 0x5b, 0x62, 0x92, 0x93, 0x3a, 0x39, 0x69, 0x93, 0x93,
-	0x67, 0x90, 0x3e, 0x55, 0x22, 0x8f, 0x8a, 0xd6, 0xe7, 0xf6, 0x98, 0x7f, 0xf0, 0xfc, 0x59, 0x05,
-	0xf7, 0x4b, 0x26, 0xb3, 0x27, 0xe1, 0x31, 0x2a, 0xfc, 0xfc, 0x3d, 0x78, 0xc0, 0x85, 0x7e, 0xb7,
-	0x4c, 0x48, 0xaa, 0x24, 0x6d, 0xaf, 0xc9, 0x7c, 0xfa, 0xf8, 0xf6, 0x3d, 0xd5, 0x65, 0x01, 0xf8,
-	0xdb, 0x10, 0x67, 0x97, 0xad, 0xc6, 0x88, 0xe1, 0xb4, 0x51, 0x70, 0x3f, 0xda, 0x8e, 0x97, 0x94,
-	0x05, 0x43, 0x8c, 0xa5, 0xc8, 0xe3, 0x39, 0x40, 0x2c, 0x91, 0xc7, 0x0d, 0xcf, 0x3b, 0xeb, 0x9e,
-	0xf7, 0xee, 0x44, 0xe3, 0x7d, 0x15, 0x84, 0xa7, 0x30, 0xff, 0x04, 0x0d, 0x4c, 0xd0, 0x53, 0xd8,
-	0x70, 0x76, 0x65, 0x46, 0x13, 0x91, 0x3f, 0x07, 0x98, 0x20, 0x7f, 0x55, 0xb7, 0xa3, 0x17, 0xeb,
-	0xad, 0x6f, 0x6f, 0xb6, 0xbe, 0xfd, 0x63, 0xeb, 0xdb, 0x9f, 0x76, 0xbe, 0xb5, 0xd9, 0xf9, 0xd6,
-	0xb7, 0x9d, 0x6f, 0xbd, 0x19, 0xfe, 0x75, 0xb8, 0x66, 0x59, 0x7d, 0x86, 0x08, 0x1a, 0xcd, 0x0f,
-	0x5d, 0x3d, 0xa6, 0x1f, 0xe8, 0xe1, 0x15, 0x35, 0x26, 0x49, 0xa7, 0xd9, 0xfe, 0xa3, 0x5f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xa9, 0xdf, 0x54, 0xeb, 0x5f, 0x02, 0x00, 0x00,
+	// 1122 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4d, 0x6c, 0xdc, 0x44,
+	0x14, 0xce, 0x24, 0x25, 0x34, 0x93, 0x34, 0x59, 0x9c, 0xd0, 0xb8, 0x49, 0xbb, 0xde, 0x58, 0x50,
+	0x56, 0xb4, 0xd9, 0x6d, 0x83, 0x2a, 0x54, 0x84, 0x84, 0x70, 0x42, 0xa3, 0x48, 0x44, 0x8a, 0x4c,
+	0x7a, 0x80, 0x8b, 0x99, 0xf5, 0xbe, 0xb8, 0x16, 0xfe, 0x59, 0x79, 0x66, 0x83, 0x73, 0x84, 0x1b,
+	0x37, 0x2e, 0x80, 0x90, 0x38, 0x70, 0x46, 0x48, 0xdc, 0x38, 0x71, 0xe4, 0x50, 0x89, 0x4b, 0x8f,
+	0x88, 0x83, 0x41, 0xc9, 0x05, 0xad, 0x38, 0x45, 0xe2, 0x8e, 0x66, 0x3c, 0xd9, 0xb5, 0x1d, 0x6f,
+	0xb2, 0xab, 0x8a, 0x53, 0x36, 0x6f, 0xbe, 0xf7, 0xcd, 0xf7, 0xde, 0xbc, 0x99, 0xf7, 0x8c, 0x97,
+	0x1d, 0x2f, 0x6c, 0x11, 0xef, 0x00, 0xa0, 0xe9, 0x40, 0x00, 0xd4, 0xa5, 0x8d, 0x4e, 0x14, 0xb2,
+	0x50, 0x59, 0x08, 0xc2, 0x96, 0x07, 0x8d, 0xfe, 0xf2, 0x4a, 0xd5, 0x0e, 0xa9, 0x1f, 0xd2, 0x66,
+	0x8b, 0x50, 0x68, 0x1e, 0xde, 0x6f, 0x01, 0x23, 0xf7, 0x9b, 0x76, 0xe8, 0x06, 0xa9, 0xc3, 0xca,
+	0x92, 0x13, 0x3a, 0xa1, 0xf8, 0xd9, 0xe4, 0xbf, 0x52, 0xab, 0xfe, 0x2f, 0xc2, 0x73, 0xdb, 0x29,
+	0xf1, 0x07, 0x8c, 0x30, 0x50, 0x76, 0xf0, 0x74, 0x87, 0x44, 0xc4, 0xa7, 0x2a, 0xaa, 0xa1, 0xfa,
+	0xec, 0xc6, 0x72, 0xa3, 0xb0, 0x51, 0x63, 0x4f, 0x2c, 0x1b, 0xea, 0xd3, 0x44, 0x9b, 0xe8, 0x25,
+	0x5a, 0x25, 0x85, 0xdf, 0x0d, 0x7d, 0x97, 0x81, 0xdf, 0x61, 0x47, 0xa6, 0x24, 0x50, 0xbe, 0x45,
+	0x78, 0x91, 0xab, 0xb1, 0x0e, 0x00, 0x2c, 0xbf, 0xeb, 0x31, 0xb7, 0xe3, 0xb9, 0x10, 0xa9, 0x93,
+	0x35, 0x54, 0x9f, 0x31, 0xdc, 0x3f, 0x12, 0xed, 0xb6, 0xe3, 0xb2, 0x27, 0xdd, 0x56, 0xc3, 0x0e,
+	0xfd, 0xa6, 0x94, 0x9f, 0xfe, 0x59, 0xa7, 0xed, 0x4f, 0x9a, 0xec, 0xa8, 0x03, 0xb4, 0xb1, 0x05,
+	0x76, 0x2f, 0xd1, 0x6e, 0x95, 0x10, 0x0d, 0xb6, 0x3c, 0x4d, 0xb4, 0x95, 0x23, 0xe2, 0x7b, 0x6f,
+	0xe9, 0x25, 0x30, 0xdd, 0x7c, 0x89, 0x5b, 0x1f, 0x01, 0xec, 0x0e, 0x6c, 0xdf, 0x2c, 0xe1, 0xe9,
+	0x34, 0x10, 0xe5, 0x17, 0x84, 0x15, 0xdf, 0x0d, 0x5c, 0xbf, 0xeb, 0x5b, 0x0e, 0xa1, 0x56, 0x27,
+	0x72, 0x6d, 0xe0, 0xe1, 0x4f, 0xd5, 0x67, 0x37, 0x6e, 0x36, 0x52, 0x41, 0x0d, 0x4e, 0xd1, 0x90,
+	0x69, 0xe5, 0x9a, 0x36, 0x43, 0x37, 0x30, 0x3a, 0x32, 0x07, 0x37, 0xcf, 0xfb, 0xe7, 0xc4, 0xdd,
+	0x48, 0xc5, 0x9d, 0x47, 0xe9, 0x3f, 0xfc, 0xa9, 0xdd, 0x19, 0x2d, 0x09, 0x7c, 0x43, 0x6a, 0x56,
+	0x24, 0xc7, 0x36, 0xa1, 0x7b, 0x82, 0x41, 0xf9, 0x0c, 0x61, 0xb5, 0x75, 0xd4, 0x21, 0x94, 0x5a,
+	0xbe, 0x1b, 0xa4, 0xb1, 0x53, 0xc7, 0x12, 0x7e, 0xea, 0x64, 0x6d, 0xaa, 0x3e, 0x63, 0xec, 0xf4,
+	0x12, 0x4d, 0x1f, 0x86, 0xc9, 0x09, 0xd5, 0x64, 0x16, 0x87, 0x60, 0x75, 0x73, 0x29, 0x5d, 0xda,
+	0x75, 0x03, 0x9e, 0x4f, 0xea, 0xec, 0x73, 0xb3, 0xf2, 0x31, 0x5e, 0x88, 0xe0, 0x00, 0x22, 0x08,
+	0x6c, 0xb0, 0xda, 0x10, 0x84, 0xbe, 0x3a, 0x25, 0x0e, 0xf9, 0xcd, 0x5e, 0xa2, 0xdd, 0x28, 0x2c,
+	0xe5, 0x36, 0xbc, 0x9e, 0x6e, 0x58, 0x80, 0xe8, 0xe6, 0x7c, 0xdf, 0xb2, 0xc5, 0x0d, 0xca, 0xaf,
+	0x08, 0x2f, 0x71, 0x29, 0x76, 0x18, 0x1c, 0x42, 0x44, 0xdd, 0x30, 0xb0, 0x22, 0xc2, 0x80, 0xaa,
+	0x57, 0x46, 0x38, 0x26, 0x26, 0x8f, 0xa9, 0x5a, 0xc6, 0x90, 0x93, 0xb3, 0x9a, 0xca, 0x29, 0xc3,
+	0x8d, 0x7d, 0x54, 0xca, 0x01, 0xc0, 0x66, 0x9f, 0xc4, 0xe4, 0x1c, 0xca, 0x57, 0x08, 0x2f, 0x9e,
+	0x65, 0x33, 0x5b, 0x6c, 0x2f, 0x88, 0x28, 0xd6, 0xce, 0xdd, 0x35, 0x99, 0xe1, 0xfe, 0x69, 0x1b,
+	0x9b, 0x32, 0x94, 0x5b, 0x25, 0x2c, 0x65, 0xf7, 0xa1, 0x04, 0xa6, 0x9b, 0x15, 0xbf, 0x40, 0xab,
+	0x7c, 0x7e, 0xbe, 0x88, 0x48, 0xbb, 0x1d, 0x01, 0xa5, 0x40, 0xd5, 0xe9, 0xa1, 0x45, 0xd4, 0xc7,
+	0x8c, 0x50, 0x44, 0x7d, 0xac, 0x6e, 0xbe, 0x9c, 0x2d, 0xa2, 0x77, 0xcf, 0xec, 0xca, 0x6f, 0x08,
+	0xdf, 0x91, 0x28, 0x2b, 0x02, 0xca, 0x22, 0xd7, 0x66, 0xd0, 0xb6, 0x86, 0x16, 0xf7, 0x8b, 0x42,
+	0x57, 0xd8, 0x4b, 0xb4, 0x07, 0x63, 0xb8, 0xe5, 0xa4, 0x6e, 0xa4, 0x52, 0xc7, 0x70, 0xd7, 0xcd,
+	0x57, 0x25, 0xda, 0xec, 0x83, 0x8d, 0xb2, 0x3b, 0xf1, 0x33, 0xc2, 0xb7, 0x7d, 0x12, 0x5b, 0x2c,
+	0x64, 0xc4, 0x2b, 0xa3, 0xe3, 0x27, 0xd2, 0xa5, 0xc4, 0x01, 0xf5, 0x6a, 0x0d, 0xd5, 0xaf, 0x18,
+	0xd0, 0x4b, 0xb4, 0x7b, 0xa3, 0x79, 0xe4, 0x62, 0x58, 0x97, 0x27, 0x3d, 0x92, 0xa7, 0x6e, 0x6a,
+	0x3e, 0x89, 0xf7, 0x39, 0xae, 0xa0, 0x7a, 0x9b, 0xd0, 0xc7, 0x1c, 0xa1, 0xfc, 0x2d, 0x85, 0x0f,
+	0xc9, 0xc0, 0x80, 0x8b, 0xaa, 0x33, 0xa2, 0x6c, 0xef, 0x9e, 0x2b, 0xdb, 0x92, 0x84, 0x9c, 0xd1,
+	0x1b, 0xae, 0xac, 0xe0, 0x7b, 0xa3, 0xed, 0x31, 0x2c, 0xd4, 0xcb, 0x3d, 0xd3, 0x50, 0x2f, 0x90,
+	0x42, 0x95, 0xef, 0x11, 0xae, 0x41, 0x70, 0x10, 0x46, 0x36, 0x58, 0xa9, 0x7a, 0x41, 0xe6, 0x06,
+	0x56, 0x1b, 0x3c, 0xf7, 0x10, 0x22, 0x8b, 0xc5, 0x2a, 0xae, 0xa1, 0xfa, 0x55, 0xe3, 0xc3, 0x5e,
+	0xa2, 0xbd, 0x7e, 0x19, 0x36, 0x27, 0xf6, 0xb5, 0x54, 0xec, 0x65, 0x3e, 0xba, 0xb9, 0x2a, 0x21,
+	0xdb, 0x02, 0xf1, 0x08, 0x60, 0x27, 0xd8, 0x4a, 0x97, 0xf7, 0x63, 0xe5, 0x0b, 0x84, 0x57, 0x0a,
+	0xb1, 0xb2, 0x98, 0xdf, 0xe4, 0x30, 0x72, 0xd9, 0x91, 0x3a, 0x5b, 0x43, 0xf5, 0x29, 0x63, 0xb7,
+	0x97, 0x68, 0xaf, 0x0c, 0x47, 0xe5, 0x64, 0xad, 0x95, 0xde, 0xce, 0x0c, 0x5a, 0x37, 0xaf, 0x67,
+	0xef, 0xe7, 0x7e, 0xbc, 0x27, 0x17, 0x14, 0x1b, 0x57, 0xfa, 0xfd, 0x15, 0x02, 0xd2, 0xf2, 0xa0,
+	0xad, 0xce, 0x89, 0xec, 0x3c, 0xec, 0x25, 0xda, 0x4a, 0x71, 0x2d, 0xb7, 0xed, 0x72, 0xa1, 0x3f,
+	0x4b, 0x8c, 0x6e, 0xce, 0xcb, 0xe6, 0xfc, 0x5e, 0x6a, 0x50, 0x82, 0xcc, 0xd0, 0xc0, 0x48, 0xe4,
+	0x00, 0xe3, 0x67, 0xaa, 0x5e, 0x13, 0x77, 0xe4, 0x9d, 0xdc, 0x28, 0x30, 0x58, 0xbe, 0x70, 0x14,
+	0x18, 0xc0, 0x74, 0xb3, 0x22, 0x77, 0xdb, 0x17, 0xb6, 0x6d, 0x42, 0x95, 0x9f, 0xf8, 0xd3, 0x77,
+	0x06, 0xe5, 0x95, 0x65, 0x3f, 0x21, 0x81, 0x03, 0xe2, 0xd1, 0x57, 0xe7, 0x45, 0x17, 0xfb, 0x94,
+	0x97, 0xec, 0x58, 0xe3, 0x8a, 0x3e, 0x8c, 0xb1, 0xf4, 0xa1, 0x1c, 0x82, 0xe5, 0xdd, 0x56, 0x0e,
+	0x2e, 0x24, 0xde, 0x14, 0x76, 0xde, 0x45, 0x94, 0x1f, 0x11, 0x5e, 0x1e, 0xf8, 0xb8, 0x41, 0x76,
+	0xb6, 0x5a, 0x10, 0x82, 0xbb, 0x63, 0x0b, 0x5e, 0x1b, 0x42, 0x98, 0xd3, 0x5b, 0x2d, 0xea, 0xcd,
+	0x41, 0x33, 0x72, 0xdd, 0x60, 0x30, 0x6a, 0x15, 0xe4, 0x92, 0x38, 0x2b, 0xb7, 0xf2, 0xfc, 0x72,
+	0x49, 0x3c, 0xb2, 0x5c, 0x12, 0x97, 0xcb, 0x25, 0x71, 0x66, 0x32, 0xfc, 0x07, 0xe1, 0x4a, 0xb1,
+	0xed, 0x2a, 0x0f, 0xf1, 0x5c, 0xff, 0x85, 0xe9, 0x46, 0x9e, 0x98, 0x8d, 0x67, 0x8c, 0xe5, 0xd3,
+	0x44, 0x5b, 0x2c, 0xf4, 0xd9, 0x6e, 0xe4, 0xe9, 0x26, 0x96, 0x0d, 0xf6, 0x71, 0xe4, 0x29, 0xdf,
+	0x95, 0x8f, 0x97, 0x93, 0x23, 0xcc, 0x2d, 0x7b, 0x3c, 0x2f, 0xff, 0xef, 0xf8, 0xa8, 0x7f, 0x8d,
+	0xf0, 0xea, 0x05, 0x6f, 0xe4, 0xf3, 0x44, 0xfe, 0x36, 0xbe, 0xc6, 0x53, 0x3e, 0xe8, 0x73, 0x93,
+	0xe2, 0x0e, 0xab, 0xa7, 0x89, 0xb6, 0x34, 0x78, 0xc8, 0x33, 0xad, 0x69, 0xd6, 0x27, 0x71, 0xbf,
+	0x4f, 0xbc, 0xff, 0xf4, 0xb8, 0x8a, 0x9e, 0x1d, 0x57, 0xd1, 0x5f, 0xc7, 0x55, 0xf4, 0xe5, 0x49,
+	0x75, 0xe2, 0xd9, 0x49, 0x75, 0xe2, 0xf7, 0x93, 0xea, 0xc4, 0x47, 0x1b, 0x99, 0x88, 0x45, 0xe7,
+	0x59, 0x27, 0x94, 0x02, 0xa3, 0xe9, 0x3f, 0xcd, 0xc3, 0x07, 0xcd, 0xb8, 0x39, 0xf8, 0x6c, 0x12,
+	0x19, 0x68, 0x4d, 0x8b, 0xcf, 0x9d, 0x37, 0xfe, 0x1b, 0x00, 0x14, 0xee, 0x82, 0x2f, 0x50, 0x0d,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -185,6 +470,18 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BaseFeeMultiplier != nil {
+		{
+			size := m.BaseFeeMultiplier.Size()
+			i -= size
+			if _, err := m.BaseFeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -218,6 +515,140 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BaseFeeMaxMultiplier.Size()
+		i -= size
+		if _, err := m.BaseFeeMaxMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.BaseFeeMinMultiplier.Size()
+		i -= size
+		if _, err := m.BaseFeeMinMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.BaseFeeMaxChangeRate.Size()
+		i -= size
+		if _, err := m.BaseFeeMaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.BaseFeeTargetGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BaseFeeTargetGas))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.BaseFeeEnabled {
+		i--
+		if m.BaseFeeEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.BypassMinFeeTxPriority != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BypassMinFeeTxPriority))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.EnforceGlobalFeeInDeliverTx {
+		i--
+		if m.EnforceGlobalFeeInDeliverTx {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.MaxBypassMinFeeMsgTypeGasUsages) > 0 {
+		for iNdEx := len(m.MaxBypassMinFeeMsgTypeGasUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxBypassMinFeeMsgTypeGasUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.MaxTotalBypassMinFeeMsgGasUsage != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxTotalBypassMinFeeMsgGasUsage))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.AddressRestrictedBypassMinFeeMsgTypes) > 0 {
+		for iNdEx := len(m.AddressRestrictedBypassMinFeeMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddressRestrictedBypassMinFeeMsgTypes[iNdEx])
+			copy(dAtA[i:], m.AddressRestrictedBypassMinFeeMsgTypes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AddressRestrictedBypassMinFeeMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BypassMinFeeAddresses) > 0 {
+		for iNdEx := len(m.BypassMinFeeAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BypassMinFeeAddresses[iNdEx])
+			copy(dAtA[i:], m.BypassMinFeeAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BypassMinFeeAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MsgTypeGasPrices) > 0 {
+		for iNdEx := len(m.MsgTypeGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgTypeGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.FeeConversionRates) > 0 {
+		for iNdEx := len(m.FeeConversionRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeConversionRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ReferenceDenom) > 0 {
+		i -= len(m.ReferenceDenom)
+		copy(dAtA[i:], m.ReferenceDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ReferenceDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.BypassMinFeeMsgTypes) > 0 {
 		for iNdEx := len(m.BypassMinFeeMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.BypassMinFeeMsgTypes[iNdEx])
@@ -234,66 +665,871 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTypeGasPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTypeGasPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTypeGasPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BypassMinFeeMsgTypeGasUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BypassMinFeeMsgTypeGasUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BypassMinFeeMsgTypeGasUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGasUsage != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxGasUsage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.BaseFeeMultiplier != nil {
+		l = m.BaseFeeMultiplier.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BypassMinFeeMsgTypes) > 0 {
+		for _, s := range m.BypassMinFeeMsgTypes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.ReferenceDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.FeeConversionRates) > 0 {
+		for _, e := range m.FeeConversionRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MsgTypeGasPrices) > 0 {
+		for _, e := range m.MsgTypeGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BypassMinFeeAddresses) > 0 {
+		for _, s := range m.BypassMinFeeAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AddressRestrictedBypassMinFeeMsgTypes) > 0 {
+		for _, s := range m.AddressRestrictedBypassMinFeeMsgTypes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxTotalBypassMinFeeMsgGasUsage != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxTotalBypassMinFeeMsgGasUsage))
+	}
+	if len(m.MaxBypassMinFeeMsgTypeGasUsages) > 0 {
+		for _, e := range m.MaxBypassMinFeeMsgTypeGasUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EnforceGlobalFeeInDeliverTx {
+		n += 2
+	}
+	if m.BypassMinFeeTxPriority != 0 {
+		n += 1 + sovGenesis(uint64(m.BypassMinFeeTxPriority))
+	}
+	if m.BaseFeeEnabled {
+		n += 2
+	}
+	if m.BaseFeeTargetGas != 0 {
+		n += 1 + sovGenesis(uint64(m.BaseFeeTargetGas))
+	}
+	l = m.BaseFeeMaxChangeRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BaseFeeMinMultiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BaseFeeMaxMultiplier.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *MsgTypeGasPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BypassMinFeeMsgTypeGasUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MaxGasUsage != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxGasUsage))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.BaseFeeMultiplier = &v
+			if err := m.BaseFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassMinFeeMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BypassMinFeeMsgTypes = append(m.BypassMinFeeMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeConversionRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeConversionRates = append(m.FeeConversionRates, types.DecCoin{})
+			if err := m.FeeConversionRates[len(m.FeeConversionRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeGasPrices = append(m.MsgTypeGasPrices, MsgTypeGasPrices{})
+			if err := m.MsgTypeGasPrices[len(m.MsgTypeGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassMinFeeAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BypassMinFeeAddresses = append(m.BypassMinFeeAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressRestrictedBypassMinFeeMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressRestrictedBypassMinFeeMsgTypes = append(m.AddressRestrictedBypassMinFeeMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalBypassMinFeeMsgGasUsage", wireType)
+			}
+			m.MaxTotalBypassMinFeeMsgGasUsage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalBypassMinFeeMsgGasUsage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBypassMinFeeMsgTypeGasUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxBypassMinFeeMsgTypeGasUsages = append(m.MaxBypassMinFeeMsgTypeGasUsages, BypassMinFeeMsgTypeGasUsage{})
+			if err := m.MaxBypassMinFeeMsgTypeGasUsages[len(m.MaxBypassMinFeeMsgTypeGasUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnforceGlobalFeeInDeliverTx", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnforceGlobalFeeInDeliverTx = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassMinFeeTxPriority", wireType)
+			}
+			m.BypassMinFeeTxPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BypassMinFeeTxPriority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0xa
+			m.BaseFeeEnabled = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeTargetGas", wireType)
+			}
+			m.BaseFeeTargetGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeTargetGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeMaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeMaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeMinMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeMinMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeMaxMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeMaxMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.MinimumGasPrices) > 0 {
-		for _, e := range m.MinimumGasPrices {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.BypassMinFeeMsgTypes) > 0 {
-		for _, s := range m.BypassMinFeeMsgTypes {
-			l = len(s)
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *MsgTypeGasPrices) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -316,15 +1552,47 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTypeGasPrices: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTypeGasPrices: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -351,7 +1619,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -376,7 +1645,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *BypassMinFeeMsgTypeGasUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -399,17 +1668,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: BypassMinFeeMsgTypeGasUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BypassMinFeeMsgTypeGasUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -419,31 +1688,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
-			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BypassMinFeeMsgTypes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasUsage", wireType)
 			}
-			var stringLen uint64
+			m.MaxGasUsage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -453,24 +1720,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxGasUsage |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BypassMinFeeMsgTypes = append(m.BypassMinFeeMsgTypes, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4,5 +4,11 @@ const (
 	// ModuleName is the name of the this module
 	ModuleName = "globalfee"
 
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
 	QuerierRoute = ModuleName
 )
+
+// BaseFeeMultiplierKey is the store key of the current base fee multiplier.
+var BaseFeeMultiplierKey = []byte("BaseFeeMultiplier")
@@ -12,6 +12,36 @@ import (
 var (
 	ParamStoreKeyMinGasPrices         = []byte("MinimumGasPricesParam")
 	ParamStoreKeyBypassMinFeeMsgTypes = []byte("BypassMinFeeMsgTypesParam")
+	ParamStoreKeyReferenceDenom       = []byte("ReferenceDenomParam")
+	ParamStoreKeyFeeConversionRates   = []byte("FeeConversionRatesParam")
+	ParamStoreKeyMsgTypeGasPrices     = []byte("MsgTypeGasPricesParam")
+
+	ParamStoreKeyBypassMinFeeAddresses                 = []byte("BypassMinFeeAddressesParam")
+	ParamStoreKeyAddressRestrictedBypassMinFeeMsgTypes = []byte("AddressRestrictedBypassMinFeeMsgTypesParam")
+
+	ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage = []byte("MaxTotalBypassMinFeeMsgGasUsageParam")
+	ParamStoreKeyMaxBypassMinFeeMsgTypeGasUsages = []byte("MaxBypassMinFeeMsgTypeGasUsagesParam")
+
+	ParamStoreKeyEnforceGlobalFeeInDeliverTx = []byte("EnforceGlobalFeeInDeliverTxParam")
+	ParamStoreKeyBypassMinFeeTxPriority      = []byte("BypassMinFeeTxPriorityParam")
+
+	ParamStoreKeyBaseFeeEnabled       = []byte("BaseFeeEnabledParam")
+	ParamStoreKeyBaseFeeTargetGas     = []byte("BaseFeeTargetGasParam")
+	ParamStoreKeyBaseFeeMaxChangeRate = []byte("BaseFeeMaxChangeRateParam")
+	ParamStoreKeyBaseFeeMinMultiplier = []byte("BaseFeeMinMultiplierParam")
+	ParamStoreKeyBaseFeeMaxMultiplier = []byte("BaseFeeMaxMultiplierParam")
+)
+
+// DefaultMaxTotalBypassMinFeeMsgGasUsage is the default maximum gas limit of a
+// transaction that contains only bypass message types and pays no fee.
+const DefaultMaxTotalBypassMinFeeMsgGasUsage uint64 = 1_000_000
+
+// Defaults of the dynamic base fee, which is disabled by default.
+var (
+	DefaultBaseFeeTargetGas     uint64 = 10_000_000
+	DefaultBaseFeeMaxChangeRate        = sdk.NewDecWithPrec(125, 3)
+	DefaultBaseFeeMinMultiplier        = sdk.OneDec()
+	DefaultBaseFeeMaxMultiplier        = sdk.NewDec(10)
 )
 
 // DefaultParams returns default parameters
@@ -58,6 +88,24 @@ func DefaultParams() Params {
 			"/noble.tokenfactory.MsgConfigureMinterController",
 			"/noble.tokenfactory.MsgRemoveMinterController",
 		},
+		ReferenceDenom:     "",
+		FeeConversionRates: sdk.DecCoins{},
+		MsgTypeGasPrices:   []MsgTypeGasPrices{},
+
+		BypassMinFeeAddresses:                 []string{},
+		AddressRestrictedBypassMinFeeMsgTypes: []string{},
+
+		MaxTotalBypassMinFeeMsgGasUsage: DefaultMaxTotalBypassMinFeeMsgGasUsage,
+		MaxBypassMinFeeMsgTypeGasUsages: []BypassMinFeeMsgTypeGasUsage{},
+
+		EnforceGlobalFeeInDeliverTx: false,
+		BypassMinFeeTxPriority:      0,
+
+		BaseFeeEnabled:       false,
+		BaseFeeTargetGas:     DefaultBaseFeeTargetGas,
+		BaseFeeMaxChangeRate: DefaultBaseFeeMaxChangeRate,
+		BaseFeeMinMultiplier: DefaultBaseFeeMinMultiplier,
+		BaseFeeMaxMultiplier: DefaultBaseFeeMaxMultiplier,
 	}
 }
 
@@ -67,7 +115,71 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 // ValidateBasic performs basic validation.
 func (p Params) ValidateBasic() error {
-	return validateMinimumGasPrices(p.MinimumGasPrices)
+	if err := validateMinimumGasPrices(p.MinimumGasPrices); err != nil {
+		return err
+	}
+	if err := validateReferenceDenom(p.ReferenceDenom); err != nil {
+		return err
+	}
+	if err := validateFeeConversionRates(p.FeeConversionRates); err != nil {
+		return err
+	}
+	if err := validateMsgTypeGasPrices(p.MsgTypeGasPrices); err != nil {
+		return err
+	}
+	if err := validateBypassMinFeeAddresses(p.BypassMinFeeAddresses); err != nil {
+		return err
+	}
+	if err := validateAddressRestrictedBypassMinFeeMsgTypes(p.AddressRestrictedBypassMinFeeMsgTypes); err != nil {
+		return err
+	}
+	if err := validateMaxTotalBypassMinFeeMsgGasUsage(p.MaxTotalBypassMinFeeMsgGasUsage); err != nil {
+		return err
+	}
+	if err := validateMaxBypassMinFeeMsgTypeGasUsages(p.MaxBypassMinFeeMsgTypeGasUsages); err != nil {
+		return err
+	}
+	if err := validateEnforceGlobalFeeInDeliverTx(p.EnforceGlobalFeeInDeliverTx); err != nil {
+		return err
+	}
+	if err := validateBypassMinFeeTxPriority(p.BypassMinFeeTxPriority); err != nil {
+		return err
+	}
+	if err := p.ValidateBaseFee(); err != nil {
+		return err
+	}
+
+	return p.validateFeeConversion()
+}
+
+// validateFeeConversion checks that the reference denom and the fee conversion
+// rates are set together, and that the rates only cover denoms without an
+// explicit minimum gas price. The params store validates every param on its
+// own, so the params in store may still break these rules, in which case the
+// rates are ignored, see Params.effectiveFeeConversionRates.
+func (p Params) validateFeeConversion() error {
+	if len(p.FeeConversionRates) == 0 {
+		if p.ReferenceDenom != "" {
+			return fmt.Errorf("fee conversion rates must be defined when reference denom %s is set", p.ReferenceDenom)
+		}
+		return nil
+	}
+	if p.ReferenceDenom == "" {
+		return fmt.Errorf("reference denom must be set when fee conversion rates are defined")
+	}
+	if ok, _ := findDecCoin(p.MinimumGasPrices, p.ReferenceDenom); !ok {
+		return fmt.Errorf("reference denom %s is not listed in minimum gas prices", p.ReferenceDenom)
+	}
+	for _, rate := range p.FeeConversionRates {
+		if rate.Denom == p.ReferenceDenom {
+			return fmt.Errorf("fee conversion rate defined for reference denom %s", rate.Denom)
+		}
+		if ok, _ := findDecCoin(p.MinimumGasPrices, rate.Denom); ok {
+			return fmt.Errorf("fee conversion rate denom %s is already listed in minimum gas prices", rate.Denom)
+		}
+	}
+
+	return nil
 }
 
 // ParamSetPairs returns the parameter set pairs.
@@ -79,9 +191,150 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(
 			ParamStoreKeyBypassMinFeeMsgTypes, &p.BypassMinFeeMsgTypes, validateBypassMinFeeMsgTypes,
 		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyReferenceDenom, &p.ReferenceDenom, validateReferenceDenom,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyFeeConversionRates, &p.FeeConversionRates, validateFeeConversionRates,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyMsgTypeGasPrices, &p.MsgTypeGasPrices, validateMsgTypeGasPrices,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyBypassMinFeeAddresses, &p.BypassMinFeeAddresses, validateBypassMinFeeAddresses,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyAddressRestrictedBypassMinFeeMsgTypes, &p.AddressRestrictedBypassMinFeeMsgTypes, validateAddressRestrictedBypassMinFeeMsgTypes,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage, &p.MaxTotalBypassMinFeeMsgGasUsage, validateMaxTotalBypassMinFeeMsgGasUsage,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyMaxBypassMinFeeMsgTypeGasUsages, &p.MaxBypassMinFeeMsgTypeGasUsages, validateMaxBypassMinFeeMsgTypeGasUsages,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyEnforceGlobalFeeInDeliverTx, &p.EnforceGlobalFeeInDeliverTx, validateEnforceGlobalFeeInDeliverTx,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyBypassMinFeeTxPriority, &p.BypassMinFeeTxPriority, validateBypassMinFeeTxPriority,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyBaseFeeEnabled, &p.BaseFeeEnabled, validateBaseFeeEnabled,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyBaseFeeTargetGas, &p.BaseFeeTargetGas, validateBaseFeeTargetGas,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyBaseFeeMaxChangeRate, &p.BaseFeeMaxChangeRate, validateBaseFeeMaxChangeRate,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyBaseFeeMinMultiplier, &p.BaseFeeMinMultiplier, validateBaseFeeMultiplier,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyBaseFeeMaxMultiplier, &p.BaseFeeMaxMultiplier, validateBaseFeeMultiplier,
+		),
 	}
 }
 
+// MaxBypassMinFeeGasUsage returns the maximum gas limit of a bypass transaction
+// containing the given message types, i.e. the highest limit of its message
+// types, falling back to MaxTotalBypassMinFeeMsgGasUsage for message types
+// without their own limit.
+func (p Params) MaxBypassMinFeeGasUsage(msgTypeURLs []string) uint64 {
+	if len(msgTypeURLs) == 0 {
+		return p.MaxTotalBypassMinFeeMsgGasUsage
+	}
+
+	var maxGasUsage uint64
+	for _, msgTypeURL := range msgTypeURLs {
+		gasUsage := p.MaxTotalBypassMinFeeMsgGasUsage
+		for _, override := range p.MaxBypassMinFeeMsgTypeGasUsages {
+			if override.MsgTypeUrl == msgTypeURL {
+				gasUsage = override.MaxGasUsage
+				break
+			}
+		}
+		if gasUsage > maxGasUsage {
+			maxGasUsage = gasUsage
+		}
+	}
+
+	return maxGasUsage
+}
+
+// EffectiveMinGasPrices returns the global minimum gas prices, including the
+// ones derived from the fee conversion rates.
+func (p Params) EffectiveMinGasPrices() sdk.DecCoins {
+	return EffectiveMinGasPrices(p.MinimumGasPrices, p.ReferenceDenom, p.effectiveFeeConversionRates())
+}
+
+// effectiveFeeConversionRates returns the fee conversion rates, or none if
+// they are inconsistent with the reference denom or the minimum gas prices.
+func (p Params) effectiveFeeConversionRates() sdk.DecCoins {
+	if err := p.validateFeeConversion(); err != nil {
+		return nil
+	}
+
+	return p.FeeConversionRates
+}
+
+// MsgTypeMinGasPrices returns the minimum gas prices of a message type, falling
+// back to the effective global minimum gas prices if it has no override.
+// Overrides are extended with the fee conversion rates like the global prices.
+func (p Params) MsgTypeMinGasPrices(msgTypeURL string) sdk.DecCoins {
+	if ok, override := p.FindMsgTypeGasPrices(msgTypeURL); ok {
+		return EffectiveMinGasPrices(override.MinimumGasPrices, p.ReferenceDenom, p.effectiveFeeConversionRates())
+	}
+
+	return p.EffectiveMinGasPrices()
+}
+
+// FindMsgTypeGasPrices returns the minimum gas prices override of a message type.
+func (p Params) FindMsgTypeGasPrices(msgTypeURL string) (bool, MsgTypeGasPrices) {
+	for _, override := range p.MsgTypeGasPrices {
+		if override.MsgTypeUrl == msgTypeURL {
+			return true, override
+		}
+	}
+
+	return false, MsgTypeGasPrices{}
+}
+
+// TxMinGasPrices returns the minimum gas prices of a transaction containing the
+// given message types, i.e. the prices of its most expensive message. Only the
+// denoms accepted by every message are kept, each at its highest price. If no
+// denom is accepted by every message, all denoms are kept instead.
+func (p Params) TxMinGasPrices(msgTypeURLs []string) sdk.DecCoins {
+	if len(p.MsgTypeGasPrices) == 0 || len(msgTypeURLs) == 0 {
+		return p.EffectiveMinGasPrices()
+	}
+
+	highest := make(map[string]sdk.Dec)
+	accepted := make(map[string]int)
+	for _, msgTypeURL := range msgTypeURLs {
+		for _, price := range p.MsgTypeMinGasPrices(msgTypeURL) {
+			if current, ok := highest[price.Denom]; !ok || price.Amount.GT(current) {
+				highest[price.Denom] = price.Amount
+			}
+			accepted[price.Denom]++
+		}
+	}
+
+	var all, common sdk.DecCoins
+	for denom, amount := range highest {
+		price := sdk.NewDecCoinFromDec(denom, amount)
+		all = append(all, price)
+		if accepted[denom] == len(msgTypeURLs) {
+			common = append(common, price)
+		}
+	}
+	if len(common) == 0 {
+		return all.Sort()
+	}
+
+	return common.Sort()
+}
+
 // this requires the fee non-negative
 func validateMinimumGasPrices(i interface{}) error {
 	v, ok := i.(sdk.DecCoins)
@@ -103,6 +356,170 @@ func validateBypassMinFeeMsgTypes(i interface{}) error {
 	return nil
 }
 
+// requires an empty string or a valid denom
+func validateReferenceDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected string", i)
+	}
+	if v == "" {
+		return nil
+	}
+
+	return sdk.ValidateDenom(v)
+}
+
+// this requires the rates sorted, unique and strictly positive
+func validateFeeConversionRates(i interface{}) error {
+	v, ok := i.(sdk.DecCoins)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected sdk.DecCoins", i)
+	}
+
+	if err := DecCoins(v).Validate(); err != nil {
+		return err
+	}
+	for _, rate := range v {
+		if !rate.IsPositive() {
+			return fmt.Errorf("fee conversion rate %s must be positive", rate)
+		}
+	}
+
+	return nil
+}
+
+// requires unique and valid bech32 addresses
+func validateBypassMinFeeAddresses(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected []string", i)
+	}
+
+	seenAddresses := make(map[string]bool)
+	for _, address := range v {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return sdkerrors.Wrapf(err, "invalid bypass min fee address %s", address)
+		}
+		if seenAddresses[address] {
+			return fmt.Errorf("duplicate bypass min fee address %s", address)
+		}
+		seenAddresses[address] = true
+	}
+
+	return nil
+}
+
+// requires unique and non-empty msg type urls
+func validateAddressRestrictedBypassMinFeeMsgTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected []string", i)
+	}
+
+	seenMsgTypes := make(map[string]bool)
+	for _, msgType := range v {
+		if msgType == "" {
+			return fmt.Errorf("msg type url cannot be empty")
+		}
+		if seenMsgTypes[msgType] {
+			return fmt.Errorf("duplicate msg type url %s", msgType)
+		}
+		seenMsgTypes[msgType] = true
+	}
+
+	return nil
+}
+
+// requires uint64
+func validateMaxTotalBypassMinFeeMsgGasUsage(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected uint64", i)
+	}
+
+	return nil
+}
+
+// requires unique and non-empty msg type urls
+func validateMaxBypassMinFeeMsgTypeGasUsages(i interface{}) error {
+	v, ok := i.([]BypassMinFeeMsgTypeGasUsage)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected []BypassMinFeeMsgTypeGasUsage", i)
+	}
+
+	seenMsgTypes := make(map[string]bool)
+	for _, override := range v {
+		if override.MsgTypeUrl == "" {
+			return fmt.Errorf("msg type url cannot be empty")
+		}
+		if seenMsgTypes[override.MsgTypeUrl] {
+			return fmt.Errorf("duplicate msg type url %s", override.MsgTypeUrl)
+		}
+		seenMsgTypes[override.MsgTypeUrl] = true
+	}
+
+	return nil
+}
+
+// requires unique msg type urls, each with valid and non-empty minimum gas prices
+func validateMsgTypeGasPrices(i interface{}) error {
+	v, ok := i.([]MsgTypeGasPrices)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected []MsgTypeGasPrices", i)
+	}
+
+	seenMsgTypes := make(map[string]bool)
+	for _, override := range v {
+		if override.MsgTypeUrl == "" {
+			return fmt.Errorf("msg type url cannot be empty")
+		}
+		if seenMsgTypes[override.MsgTypeUrl] {
+			return fmt.Errorf("duplicate msg type url %s", override.MsgTypeUrl)
+		}
+		if len(override.MinimumGasPrices) == 0 {
+			return fmt.Errorf("minimum gas prices of msg type %s cannot be empty", override.MsgTypeUrl)
+		}
+		if err := DecCoins(override.MinimumGasPrices).Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "minimum gas prices of msg type %s", override.MsgTypeUrl)
+		}
+		seenMsgTypes[override.MsgTypeUrl] = true
+	}
+
+	return nil
+}
+
+// EffectiveMinGasPrices returns the minimum gas prices extended with a price for
+// every denom in rates, derived as the reference denom's price times the rate.
+// Derived prices never override an explicitly listed denom, and no prices are
+// derived if the reference denom is not listed. The result is sorted.
+func EffectiveMinGasPrices(minGasPrices sdk.DecCoins, referenceDenom string, rates sdk.DecCoins) sdk.DecCoins {
+	ok, reference := findDecCoin(minGasPrices, referenceDenom)
+	if referenceDenom == "" || !ok || len(rates) == 0 {
+		return minGasPrices
+	}
+
+	prices := make(sdk.DecCoins, 0, len(minGasPrices)+len(rates))
+	prices = append(prices, minGasPrices...)
+	for _, rate := range rates {
+		if found, _ := findDecCoin(minGasPrices, rate.Denom); found {
+			continue
+		}
+		prices = append(prices, sdk.NewDecCoinFromDec(rate.Denom, reference.Amount.Mul(rate.Amount)))
+	}
+
+	return prices.Sort()
+}
+
+// findDecCoin returns the coin with the given denom, as DecCoins lacks Find in SDK v0.45.x
+func findDecCoin(coins sdk.DecCoins, denom string) (bool, sdk.DecCoin) {
+	for _, coin := range coins {
+		if coin.Denom == denom {
+			return true, coin
+		}
+	}
+
+	return false, sdk.DecCoin{}
+}
+
 // Validate checks that the DecCoins are sorted, have nonnegtive amount, with a valid and unique
 // denomination (i.e no duplicates). Otherwise, it returns an error.
 type DecCoins sdk.DecCoins
@@ -153,3 +570,164 @@ func (coins DecCoins) Validate() error {
 		return nil
 	}
 }
+
+func validateEnforceGlobalFeeInDeliverTx(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected bool", i)
+	}
+
+	return nil
+}
+
+func validateBypassMinFeeTxPriority(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected int64", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("bypass min fee tx priority must not be negative: %d", v)
+	}
+
+	return nil
+}
+
+// ValidateBaseFee validates the dynamic base fee params, which must describe a
+// working base fee even while it is disabled, so that enabling it is enough.
+func (p Params) ValidateBaseFee() error {
+	if err := validateBaseFeeEnabled(p.BaseFeeEnabled); err != nil {
+		return err
+	}
+	if err := validateBaseFeeTargetGas(p.BaseFeeTargetGas); err != nil {
+		return err
+	}
+	if err := validateBaseFeeMaxChangeRate(p.BaseFeeMaxChangeRate); err != nil {
+		return err
+	}
+	if err := validateBaseFeeMultiplier(p.BaseFeeMinMultiplier); err != nil {
+		return err
+	}
+	if err := validateBaseFeeMultiplier(p.BaseFeeMaxMultiplier); err != nil {
+		return err
+	}
+
+	if p.BaseFeeMaxMultiplier.LT(p.BaseFeeMinMultiplier) {
+		return fmt.Errorf("base fee max multiplier %s is lower than min multiplier %s", p.BaseFeeMaxMultiplier, p.BaseFeeMinMultiplier)
+	}
+
+	return nil
+}
+
+// WithBaseFeeDefaults returns the params with every unset dynamic base fee
+// param set to its default, as genesis files written before the dynamic base
+// fee existed leave them out.
+func (p Params) WithBaseFeeDefaults() Params {
+	if p.BaseFeeTargetGas == 0 {
+		p.BaseFeeTargetGas = DefaultBaseFeeTargetGas
+	}
+	if p.BaseFeeMaxChangeRate.IsNil() {
+		p.BaseFeeMaxChangeRate = DefaultBaseFeeMaxChangeRate
+	}
+	if p.BaseFeeMinMultiplier.IsNil() {
+		p.BaseFeeMinMultiplier = DefaultBaseFeeMinMultiplier
+	}
+	if p.BaseFeeMaxMultiplier.IsNil() {
+		p.BaseFeeMaxMultiplier = DefaultBaseFeeMaxMultiplier
+	}
+
+	return p
+}
+
+func validateBaseFeeEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected bool", i)
+	}
+
+	return nil
+}
+
+// requires a positive target gas
+func validateBaseFeeTargetGas(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected uint64", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("base fee target gas must be positive")
+	}
+
+	return nil
+}
+
+// requires a rate above zero and at most one
+func validateBaseFeeMaxChangeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected sdk.Dec", i)
+	}
+	if v.IsNil() {
+		return fmt.Errorf("base fee max change rate must be set")
+	}
+	if !v.IsPositive() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("base fee max change rate must be above 0 and at most 1: %s", v)
+	}
+
+	return nil
+}
+
+// requires a positive multiplier
+func validateBaseFeeMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected sdk.Dec", i)
+	}
+	if v.IsNil() {
+		return fmt.Errorf("base fee multiplier must be set")
+	}
+	if !v.IsPositive() {
+		return fmt.Errorf("base fee multiplier must be positive: %s", v)
+	}
+
+	return nil
+}
+
+// NextBaseFeeMultiplier returns the base fee multiplier following multiplier
+// after a block that used gasUsed gas. Like EIP-1559, the multiplier changes
+// by BaseFeeMaxChangeRate times the relative deviation of gasUsed from
+// BaseFeeTargetGas, capped at BaseFeeMaxChangeRate, and it is kept within
+// BaseFeeMinMultiplier and BaseFeeMaxMultiplier. The multiplier is kept as is
+// if the base fee params are invalid.
+func (p Params) NextBaseFeeMultiplier(multiplier sdk.Dec, gasUsed uint64) sdk.Dec {
+	if err := p.ValidateBaseFee(); err != nil {
+		return multiplier
+	}
+
+	target := sdk.NewDecFromInt(sdk.NewIntFromUint64(p.BaseFeeTargetGas))
+	deviation := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed)).Sub(target).Quo(target)
+	if deviation.GT(sdk.OneDec()) {
+		deviation = sdk.OneDec()
+	}
+	next := multiplier.Mul(sdk.OneDec().Add(p.BaseFeeMaxChangeRate.Mul(deviation)))
+
+	if next.LT(p.BaseFeeMinMultiplier) {
+		return p.BaseFeeMinMultiplier
+	}
+	if next.GT(p.BaseFeeMaxMultiplier) {
+		return p.BaseFeeMaxMultiplier
+	}
+	return next
+}
+
+// ScaleMinGasPrices multiplies every price by multiplier, keeping the zero
+// prices that mark fee denoms without a minimum.
+func ScaleMinGasPrices(prices sdk.DecCoins, multiplier sdk.Dec) sdk.DecCoins {
+	if len(prices) == 0 {
+		return prices
+	}
+
+	scaled := make(sdk.DecCoins, len(prices))
+	for i, price := range prices {
+		scaled[i] = sdk.NewDecCoinFromDec(price.Denom, price.Amount.Mul(multiplier))
+	}
+
+	return scaled
+}
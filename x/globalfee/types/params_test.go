@@ -10,6 +10,13 @@ import (
 func TestDefaultParams(t *testing.T) {
 	p := DefaultParams()
 	require.EqualValues(t, p.MinimumGasPrices, sdk.DecCoins{})
+	require.EqualValues(t, p.ReferenceDenom, "")
+	require.EqualValues(t, p.FeeConversionRates, sdk.DecCoins{})
+	require.EqualValues(t, p.MsgTypeGasPrices, []MsgTypeGasPrices{})
+	require.EqualValues(t, p.BypassMinFeeAddresses, []string{})
+	require.EqualValues(t, p.AddressRestrictedBypassMinFeeMsgTypes, []string{})
+	require.EqualValues(t, p.MaxTotalBypassMinFeeMsgGasUsage, uint64(1_000_000))
+	require.EqualValues(t, p.MaxBypassMinFeeMsgTypeGasUsages, []BypassMinFeeMsgTypeGasUsage{})
 	require.EqualValues(t, p.BypassMinFeeMsgTypes, []string{
 		"/ibc.core.client.v1.MsgUpdateClient",
 		"/ibc.core.channel.v1.MsgRecvPacket",
@@ -99,3 +106,354 @@ func Test_validateParams(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateFeeConversionRates(t *testing.T) {
+	tests := map[string]struct {
+		rates     interface{}
+		expectErr bool
+	}{
+		"DefaultParams, pass": {
+			DefaultParams().FeeConversionRates,
+			false,
+		},
+		"positive rates, pass": {
+			sdk.DecCoins{
+				sdk.NewDecCoinFromDec("eurc", sdk.NewDecWithPrec(9, 1)),
+				sdk.NewDecCoin("ustake", sdk.NewInt(4)),
+			},
+			false,
+		},
+		"zero rate, fail": {
+			sdk.DecCoins{
+				sdk.NewDecCoin("eurc", sdk.ZeroInt()),
+			},
+			true,
+		},
+		"rates are not sorted by denom alphabetically, fail": {
+			sdk.DecCoins{
+				sdk.NewDecCoin("ustake", sdk.OneInt()),
+				sdk.NewDecCoin("eurc", sdk.OneInt()),
+			},
+			true,
+		},
+		"wrong type, fail": {
+			sdk.Coins{sdk.NewCoin("eurc", sdk.OneInt())},
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateFeeConversionRates(test.rates)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestEffectiveMinGasPrices(t *testing.T) {
+	minGasPrices := sdk.DecCoins{
+		sdk.NewDecCoinFromDec("uusdc", sdk.NewDecWithPrec(1, 1)),
+	}
+	rates := sdk.DecCoins{
+		sdk.NewDecCoinFromDec("ueurc", sdk.NewDecWithPrec(9, 1)),
+		sdk.NewDecCoin("ustake", sdk.NewInt(4)),
+	}
+
+	tests := map[string]struct {
+		minGasPrices   sdk.DecCoins
+		referenceDenom string
+		rates          sdk.DecCoins
+		expected       sdk.DecCoins
+	}{
+		"no rates": {
+			minGasPrices:   minGasPrices,
+			referenceDenom: "uusdc",
+			expected:       minGasPrices,
+		},
+		"no reference denom": {
+			minGasPrices: minGasPrices,
+			rates:        rates,
+			expected:     minGasPrices,
+		},
+		"reference denom not in minimum gas prices": {
+			minGasPrices:   minGasPrices,
+			referenceDenom: "uatom",
+			rates:          rates,
+			expected:       minGasPrices,
+		},
+		"derived prices": {
+			minGasPrices:   minGasPrices,
+			referenceDenom: "uusdc",
+			rates:          rates,
+			expected: sdk.DecCoins{
+				sdk.NewDecCoinFromDec("ueurc", sdk.NewDecWithPrec(9, 2)),
+				sdk.NewDecCoinFromDec("ustake", sdk.NewDecWithPrec(4, 1)),
+				sdk.NewDecCoinFromDec("uusdc", sdk.NewDecWithPrec(1, 1)),
+			},
+		},
+		"listed price is not overridden": {
+			minGasPrices: sdk.DecCoins{
+				sdk.NewDecCoinFromDec("ustake", sdk.NewDecWithPrec(1, 2)),
+				sdk.NewDecCoinFromDec("uusdc", sdk.NewDecWithPrec(1, 1)),
+			},
+			referenceDenom: "uusdc",
+			rates:          rates,
+			expected: sdk.DecCoins{
+				sdk.NewDecCoinFromDec("ueurc", sdk.NewDecWithPrec(9, 2)),
+				sdk.NewDecCoinFromDec("ustake", sdk.NewDecWithPrec(1, 2)),
+				sdk.NewDecCoinFromDec("uusdc", sdk.NewDecWithPrec(1, 1)),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, test.expected, EffectiveMinGasPrices(test.minGasPrices, test.referenceDenom, test.rates))
+		})
+	}
+}
+
+func TestParamsEffectiveMinGasPrices(t *testing.T) {
+	params := DefaultParams()
+	params.MinimumGasPrices = sdk.DecCoins{sdk.NewDecCoinFromDec("uusdc", sdk.NewDecWithPrec(1, 1))}
+	params.ReferenceDenom = "uusdc"
+	params.FeeConversionRates = sdk.DecCoins{sdk.NewDecCoinFromDec("ueurc", sdk.NewDecWithPrec(9, 1))}
+	require.Equal(t, sdk.DecCoins{
+		sdk.NewDecCoinFromDec("ueurc", sdk.NewDecWithPrec(9, 2)),
+		sdk.NewDecCoinFromDec("uusdc", sdk.NewDecWithPrec(1, 1)),
+	}, params.EffectiveMinGasPrices())
+
+	// rates inconsistent with the other params, as the params store only
+	// validates them one by one, are ignored
+	params.MinimumGasPrices = append(params.MinimumGasPrices, sdk.NewDecCoinFromDec("uzzz", sdk.NewDecWithPrec(1, 1)))
+	params.FeeConversionRates = sdk.DecCoins{sdk.NewDecCoinFromDec("uusdc", sdk.OneDec())}
+	require.Equal(t, params.MinimumGasPrices, params.EffectiveMinGasPrices())
+	require.Equal(t, params.MinimumGasPrices, params.MsgTypeMinGasPrices("/cosmos.bank.v1beta1.MsgSend"))
+}
+
+func TestTxMinGasPrices(t *testing.T) {
+	const (
+		msgSend     = "/cosmos.bank.v1beta1.MsgSend"
+		msgTransfer = "/ibc.applications.transfer.v1.MsgTransfer"
+		msgMint     = "/noble.tokenfactory.MsgMint"
+	)
+	params := Params{
+		MinimumGasPrices: sdk.DecCoins{
+			sdk.NewDecCoinFromDec("ustake", sdk.NewDecWithPrec(4, 1)),
+			sdk.NewDecCoinFromDec("uusdc", sdk.NewDecWithPrec(1, 1)),
+		},
+		MsgTypeGasPrices: []MsgTypeGasPrices{
+			{
+				MsgTypeUrl: msgTransfer,
+				MinimumGasPrices: sdk.DecCoins{
+					sdk.NewDecCoinFromDec("uusdc", sdk.NewDecWithPrec(5, 1)),
+				},
+			},
+			{
+				MsgTypeUrl: msgMint,
+				MinimumGasPrices: sdk.DecCoins{
+					sdk.NewDecCoinFromDec("ueurc", sdk.NewDecWithPrec(1, 2)),
+					sdk.NewDecCoinFromDec("uusdc", sdk.NewDecWithPrec(1, 2)),
+				},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		msgTypeURLs []string
+		expected    sdk.DecCoins
+	}{
+		"no msgs": {
+			expected: params.MinimumGasPrices,
+		},
+		"msg without override": {
+			msgTypeURLs: []string{msgSend},
+			expected:    params.MinimumGasPrices,
+		},
+		"higher override": {
+			msgTypeURLs: []string{msgTransfer},
+			expected:    sdk.DecCoins{sdk.NewDecCoinFromDec("uusdc", sdk.NewDecWithPrec(5, 1))},
+		},
+		"lower override": {
+			msgTypeURLs: []string{msgMint, msgMint},
+			expected: sdk.DecCoins{
+				sdk.NewDecCoinFromDec("ueurc", sdk.NewDecWithPrec(1, 2)),
+				sdk.NewDecCoinFromDec("uusdc", sdk.NewDecWithPrec(1, 2)),
+			},
+		},
+		"most expensive msg wins": {
+			msgTypeURLs: []string{msgMint, msgSend, msgTransfer},
+			expected:    sdk.DecCoins{sdk.NewDecCoinFromDec("uusdc", sdk.NewDecWithPrec(5, 1))},
+		},
+		"only common denoms are kept": {
+			msgTypeURLs: []string{msgMint, msgSend},
+			expected:    sdk.DecCoins{sdk.NewDecCoinFromDec("uusdc", sdk.NewDecWithPrec(1, 1))},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, test.expected, params.TxMinGasPrices(test.msgTypeURLs))
+		})
+	}
+}
+
+func TestMaxBypassMinFeeGasUsage(t *testing.T) {
+	const (
+		msgRecvPacket   = "/ibc.core.channel.v1.MsgRecvPacket"
+		msgUpdateClient = "/ibc.core.client.v1.MsgUpdateClient"
+		msgTimeout      = "/ibc.core.channel.v1.MsgTimeout"
+	)
+	params := DefaultParams()
+	params.MaxBypassMinFeeMsgTypeGasUsages = []BypassMinFeeMsgTypeGasUsage{
+		{MsgTypeUrl: msgRecvPacket, MaxGasUsage: 5_000_000},
+		{MsgTypeUrl: msgTimeout, MaxGasUsage: 500_000},
+	}
+
+	tests := map[string]struct {
+		msgTypeURLs []string
+		expected    uint64
+	}{
+		"no msgs":                   {nil, 1_000_000},
+		"msg without own limit":     {[]string{msgUpdateClient}, 1_000_000},
+		"msg with higher limit":     {[]string{msgRecvPacket, msgRecvPacket}, 5_000_000},
+		"msg with lower limit":      {[]string{msgTimeout}, 500_000},
+		"highest limit of the msgs": {[]string{msgUpdateClient, msgRecvPacket, msgTimeout}, 5_000_000},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, test.expected, params.MaxBypassMinFeeGasUsage(test.msgTypeURLs))
+		})
+	}
+}
+
+func TestValidateBaseFee(t *testing.T) {
+	tests := map[string]struct {
+		modify    func(p *Params)
+		expectErr bool
+	}{
+		"default, disabled, pass": {
+			modify: func(p *Params) {},
+		},
+		"enabled with defaults, pass": {
+			modify: func(p *Params) { p.BaseFeeEnabled = true },
+		},
+		"disabled without values, fail": {
+			modify: func(p *Params) {
+				p.BaseFeeTargetGas = 0
+				p.BaseFeeMaxChangeRate = sdk.Dec{}
+				p.BaseFeeMinMultiplier = sdk.Dec{}
+				p.BaseFeeMaxMultiplier = sdk.Dec{}
+			},
+			expectErr: true,
+		},
+		"without change rate, fail": {
+			modify:    func(p *Params) { p.BaseFeeMaxChangeRate = sdk.Dec{} },
+			expectErr: true,
+		},
+		"zero target gas, fail": {
+			modify:    func(p *Params) { p.BaseFeeTargetGas = 0 },
+			expectErr: true,
+		},
+		"zero change rate, fail": {
+			modify:    func(p *Params) { p.BaseFeeMaxChangeRate = sdk.ZeroDec() },
+			expectErr: true,
+		},
+		"change rate above one, fail": {
+			modify:    func(p *Params) { p.BaseFeeMaxChangeRate = sdk.NewDecWithPrec(11, 1) },
+			expectErr: true,
+		},
+		"zero min multiplier, fail": {
+			modify:    func(p *Params) { p.BaseFeeMinMultiplier = sdk.ZeroDec() },
+			expectErr: true,
+		},
+		"negative min multiplier, fail": {
+			modify:    func(p *Params) { p.BaseFeeMinMultiplier = sdk.NewDec(-1) },
+			expectErr: true,
+		},
+		"without max multiplier, fail": {
+			modify:    func(p *Params) { p.BaseFeeMaxMultiplier = sdk.Dec{} },
+			expectErr: true,
+		},
+		"max below min, fail": {
+			modify:    func(p *Params) { p.BaseFeeMaxMultiplier = sdk.NewDecWithPrec(5, 1) },
+			expectErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := DefaultParams()
+			test.modify(&params)
+			err := params.ValidateBasic()
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNextBaseFeeMultiplier(t *testing.T) {
+	params := DefaultParams()
+	params.BaseFeeEnabled = true
+	params.BaseFeeTargetGas = 1_000_000
+	params.BaseFeeMaxMultiplier = sdk.NewDec(2)
+
+	tests := map[string]struct {
+		multiplier sdk.Dec
+		gasUsed    uint64
+		expected   sdk.Dec
+	}{
+		"at target":                   {sdk.NewDecWithPrec(15, 1), 1_000_000, sdk.NewDecWithPrec(15, 1)},
+		"twice the target":            {sdk.OneDec(), 2_000_000, sdk.NewDecWithPrec(1125, 3)},
+		"change is capped":            {sdk.OneDec(), 10_000_000, sdk.NewDecWithPrec(1125, 3)},
+		"half the target":             {sdk.NewDecWithPrec(15, 1), 500_000, sdk.MustNewDecFromStr("1.40625")},
+		"empty block":                 {sdk.NewDecWithPrec(15, 1), 0, sdk.MustNewDecFromStr("1.3125")},
+		"clamped to min multiplier":   {sdk.OneDec(), 0, sdk.OneDec()},
+		"clamped to max multiplier":   {sdk.NewDecWithPrec(19, 1), 2_000_000, sdk.NewDec(2)},
+		"out of bounds moves inwards": {sdk.NewDec(5), 1_000_000, sdk.NewDec(2)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, test.expected, params.NextBaseFeeMultiplier(test.multiplier, test.gasUsed))
+		})
+	}
+
+	// invalid params keep the multiplier as is
+	params.BaseFeeMaxChangeRate = sdk.Dec{}
+	require.Equal(t, sdk.NewDecWithPrec(15, 1), params.NextBaseFeeMultiplier(sdk.NewDecWithPrec(15, 1), 2_000_000))
+	params.BaseFeeMaxChangeRate = DefaultBaseFeeMaxChangeRate
+	params.BaseFeeMaxMultiplier = sdk.NewDecWithPrec(5, 1)
+	require.Equal(t, sdk.NewDecWithPrec(15, 1), params.NextBaseFeeMultiplier(sdk.NewDecWithPrec(15, 1), 2_000_000))
+}
+
+func TestWithBaseFeeDefaults(t *testing.T) {
+	params := Params{BaseFeeEnabled: true, BaseFeeMaxMultiplier: sdk.NewDec(2)}.WithBaseFeeDefaults()
+	require.True(t, params.BaseFeeEnabled)
+	require.Equal(t, DefaultBaseFeeTargetGas, params.BaseFeeTargetGas)
+	require.Equal(t, DefaultBaseFeeMaxChangeRate, params.BaseFeeMaxChangeRate)
+	require.Equal(t, DefaultBaseFeeMinMultiplier, params.BaseFeeMinMultiplier)
+	require.Equal(t, sdk.NewDec(2), params.BaseFeeMaxMultiplier)
+	require.NoError(t, params.ValidateBaseFee())
+}
+
+func TestScaleMinGasPrices(t *testing.T) {
+	prices := sdk.DecCoins{
+		sdk.NewDecCoin("ueurc", sdk.ZeroInt()),
+		sdk.NewDecCoinFromDec("uusdc", sdk.NewDecWithPrec(1, 1)),
+	}
+
+	require.Equal(t, sdk.DecCoins{
+		sdk.NewDecCoin("ueurc", sdk.ZeroInt()),
+		sdk.NewDecCoinFromDec("uusdc", sdk.NewDecWithPrec(15, 2)),
+	}, ScaleMinGasPrices(prices, sdk.NewDecWithPrec(15, 1)))
+	require.Empty(t, ScaleMinGasPrices(nil, sdk.NewDec(2)))
+}
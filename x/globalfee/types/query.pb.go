@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -111,34 +113,498 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryEffectiveMinGasPricesRequest is the request type for the
+// Query/EffectiveMinGasPrices RPC method.
+type QueryEffectiveMinGasPricesRequest struct {
+}
+
+func (m *QueryEffectiveMinGasPricesRequest) Reset()         { *m = QueryEffectiveMinGasPricesRequest{} }
+func (m *QueryEffectiveMinGasPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveMinGasPricesRequest) ProtoMessage()    {}
+func (*QueryEffectiveMinGasPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387dd811257f4eeb, []int{2}
+}
+func (m *QueryEffectiveMinGasPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveMinGasPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveMinGasPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveMinGasPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveMinGasPricesRequest.Merge(m, src)
+}
+func (m *QueryEffectiveMinGasPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveMinGasPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveMinGasPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveMinGasPricesRequest proto.InternalMessageInfo
+
+// QueryEffectiveMinGasPricesResponse is the response type for the
+// Query/EffectiveMinGasPrices RPC method.
+type QueryEffectiveMinGasPricesResponse struct {
+	// MinimumGasPrices are the minimum gas prices accepted by the chain, for
+	// both the directly listed denoms and the ones derived from conversion rates.
+	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices"`
+}
+
+func (m *QueryEffectiveMinGasPricesResponse) Reset()         { *m = QueryEffectiveMinGasPricesResponse{} }
+func (m *QueryEffectiveMinGasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveMinGasPricesResponse) ProtoMessage()    {}
+func (*QueryEffectiveMinGasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387dd811257f4eeb, []int{3}
+}
+func (m *QueryEffectiveMinGasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveMinGasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveMinGasPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveMinGasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveMinGasPricesResponse.Merge(m, src)
+}
+func (m *QueryEffectiveMinGasPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveMinGasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveMinGasPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveMinGasPricesResponse proto.InternalMessageInfo
+
+func (m *QueryEffectiveMinGasPricesResponse) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinimumGasPrices
+	}
+	return nil
+}
+
+// QueryMsgTypeMinGasPricesRequest is the request type for the
+// Query/MsgTypeMinGasPrices RPC method.
+type QueryMsgTypeMinGasPricesRequest struct {
+	// MsgTypeUrls are the type URLs of the messages in a transaction.
+	MsgTypeUrls []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+}
+
+func (m *QueryMsgTypeMinGasPricesRequest) Reset()         { *m = QueryMsgTypeMinGasPricesRequest{} }
+func (m *QueryMsgTypeMinGasPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgTypeMinGasPricesRequest) ProtoMessage()    {}
+func (*QueryMsgTypeMinGasPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387dd811257f4eeb, []int{4}
+}
+func (m *QueryMsgTypeMinGasPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgTypeMinGasPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgTypeMinGasPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgTypeMinGasPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgTypeMinGasPricesRequest.Merge(m, src)
+}
+func (m *QueryMsgTypeMinGasPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgTypeMinGasPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgTypeMinGasPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgTypeMinGasPricesRequest proto.InternalMessageInfo
+
+func (m *QueryMsgTypeMinGasPricesRequest) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+// QueryMsgTypeMinGasPricesResponse is the response type for the
+// Query/MsgTypeMinGasPrices RPC method.
+type QueryMsgTypeMinGasPricesResponse struct {
+	// MinimumGasPrices are the global minimum gas prices required for a
+	// transaction containing the requested message types.
+	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices"`
+}
+
+func (m *QueryMsgTypeMinGasPricesResponse) Reset()         { *m = QueryMsgTypeMinGasPricesResponse{} }
+func (m *QueryMsgTypeMinGasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgTypeMinGasPricesResponse) ProtoMessage()    {}
+func (*QueryMsgTypeMinGasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387dd811257f4eeb, []int{5}
+}
+func (m *QueryMsgTypeMinGasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgTypeMinGasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgTypeMinGasPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgTypeMinGasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgTypeMinGasPricesResponse.Merge(m, src)
+}
+func (m *QueryMsgTypeMinGasPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgTypeMinGasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgTypeMinGasPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgTypeMinGasPricesResponse proto.InternalMessageInfo
+
+func (m *QueryMsgTypeMinGasPricesResponse) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinimumGasPrices
+	}
+	return nil
+}
+
+// QueryBaseFeeRequest is the request type for the Query/BaseFee RPC method.
+type QueryBaseFeeRequest struct {
+}
+
+func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
+func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387dd811257f4eeb, []int{6}
+}
+func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
+
+// QueryBaseFeeResponse is the response type for the Query/BaseFee RPC method.
+type QueryBaseFeeResponse struct {
+	// Enabled reports whether the dynamic base fee is enabled.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Multiplier is the current base fee multiplier applied to the global
+	// minimum gas prices. It is one when the dynamic base fee is disabled.
+	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier"`
+	// MinimumGasPrices are the current global minimum gas prices, i.e. the
+	// effective minimum gas prices scaled by the multiplier.
+	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices"`
+}
+
+func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
+func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387dd811257f4eeb, []int{7}
+}
+func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
+
+func (m *QueryBaseFeeResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *QueryBaseFeeResponse) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinimumGasPrices
+	}
+	return nil
+}
+
+// QueryRequiredFeesRequest is the request type for the Query/RequiredFees RPC
+// method. Either tx_bytes or msg_type_urls must be set.
+type QueryRequiredFeesRequest struct {
+	// TxBytes is an encoded transaction.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// MsgTypeUrls are the type URLs of the messages in a transaction, used
+	// together with gas_limit when no encoded transaction is given. As the
+	// signers are unknown, the bypass min fee addresses are not considered.
+	MsgTypeUrls []string `protobuf:"bytes,2,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// GasLimit is the gas limit of the transaction described by msg_type_urls.
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *QueryRequiredFeesRequest) Reset()         { *m = QueryRequiredFeesRequest{} }
+func (m *QueryRequiredFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequiredFeesRequest) ProtoMessage()    {}
+func (*QueryRequiredFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387dd811257f4eeb, []int{8}
+}
+func (m *QueryRequiredFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequiredFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequiredFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRequiredFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequiredFeesRequest.Merge(m, src)
+}
+func (m *QueryRequiredFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequiredFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequiredFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequiredFeesRequest proto.InternalMessageInfo
+
+func (m *QueryRequiredFeesRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+func (m *QueryRequiredFeesRequest) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *QueryRequiredFeesRequest) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+// QueryRequiredFeesResponse is the response type for the Query/RequiredFees
+// RPC method.
+type QueryRequiredFeesResponse struct {
+	// BypassMinFee reports whether the transaction is allowed to bypass the
+	// minimum fee. A bypass transaction may pay no fee at all, but any fee it
+	// pays must be in the denoms of required_global_fees.
+	BypassMinFee bool `protobuf:"varint,1,opt,name=bypass_min_fee,json=bypassMinFee,proto3" json:"bypass_min_fee,omitempty"`
+	// RequiredGlobalFees are the global fees of the transaction per accepted
+	// denom. One of them must be paid, unless bypass_min_fee is set.
+	RequiredGlobalFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=required_global_fees,json=requiredGlobalFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"required_global_fees"`
+	// RequiredFees are the global fees raised to the minimum gas prices of the
+	// queried node, i.e. the fees it requires to accept the transaction into
+	// its mempool. Empty if bypass_min_fee is set.
+	RequiredFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=required_fees,json=requiredFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"required_fees"`
+	// Reason explains why the transaction may or may not bypass the minimum fee.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *QueryRequiredFeesResponse) Reset()         { *m = QueryRequiredFeesResponse{} }
+func (m *QueryRequiredFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRequiredFeesResponse) ProtoMessage()    {}
+func (*QueryRequiredFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387dd811257f4eeb, []int{9}
+}
+func (m *QueryRequiredFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequiredFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequiredFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRequiredFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequiredFeesResponse.Merge(m, src)
+}
+func (m *QueryRequiredFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequiredFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequiredFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequiredFeesResponse proto.InternalMessageInfo
+
+func (m *QueryRequiredFeesResponse) GetBypassMinFee() bool {
+	if m != nil {
+		return m.BypassMinFee
+	}
+	return false
+}
+
+func (m *QueryRequiredFeesResponse) GetRequiredGlobalFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RequiredGlobalFees
+	}
+	return nil
+}
+
+func (m *QueryRequiredFeesResponse) GetRequiredFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RequiredFees
+	}
+	return nil
+}
+
+func (m *QueryRequiredFeesResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "noble.globalfee.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "noble.globalfee.QueryParamsResponse")
+	proto.RegisterType((*QueryEffectiveMinGasPricesRequest)(nil), "noble.globalfee.QueryEffectiveMinGasPricesRequest")
+	proto.RegisterType((*QueryEffectiveMinGasPricesResponse)(nil), "noble.globalfee.QueryEffectiveMinGasPricesResponse")
+	proto.RegisterType((*QueryMsgTypeMinGasPricesRequest)(nil), "noble.globalfee.QueryMsgTypeMinGasPricesRequest")
+	proto.RegisterType((*QueryMsgTypeMinGasPricesResponse)(nil), "noble.globalfee.QueryMsgTypeMinGasPricesResponse")
+	proto.RegisterType((*QueryBaseFeeRequest)(nil), "noble.globalfee.QueryBaseFeeRequest")
+	proto.RegisterType((*QueryBaseFeeResponse)(nil), "noble.globalfee.QueryBaseFeeResponse")
+	proto.RegisterType((*QueryRequiredFeesRequest)(nil), "noble.globalfee.QueryRequiredFeesRequest")
+	proto.RegisterType((*QueryRequiredFeesResponse)(nil), "noble.globalfee.QueryRequiredFeesResponse")
 }
 
 func init() { proto.RegisterFile("globalfee/query.proto", fileDescriptor_387dd811257f4eeb) }
 
 var fileDescriptor_387dd811257f4eeb = []byte{
-	// 289 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4d, 0xcf, 0xc9, 0x4f,
-	0x4a, 0xcc, 0x49, 0x4b, 0x4d, 0xd5, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0xe2, 0xcf, 0xcb, 0x4f, 0xca, 0x49, 0xd5, 0x83, 0x4b, 0x4a, 0x89, 0x23, 0xd4, 0xa5,
-	0xa7, 0xe6, 0xa5, 0x16, 0x67, 0x16, 0x43, 0x54, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99,
-	0xfa, 0x20, 0x16, 0x54, 0x54, 0x26, 0x3d, 0x3f, 0x3f, 0x3d, 0x27, 0x55, 0x3f, 0xb1, 0x20, 0x53,
-	0x3f, 0x31, 0x2f, 0x2f, 0xbf, 0x24, 0xb1, 0x24, 0x33, 0x3f, 0x0f, 0xaa, 0x47, 0x49, 0x84, 0x4b,
-	0x28, 0x10, 0x64, 0x59, 0x40, 0x62, 0x51, 0x62, 0x6e, 0x71, 0x50, 0x6a, 0x61, 0x69, 0x6a, 0x71,
-	0x89, 0x92, 0x0f, 0x97, 0x30, 0x8a, 0x68, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x90, 0x29, 0x17,
-	0x5b, 0x01, 0x58, 0x44, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x5c, 0x0f, 0xcd, 0x6d, 0x7a,
-	0x10, 0x0d, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x15, 0x1b, 0xb5, 0x32, 0x72, 0xb1,
-	0x82, 0x8d, 0x13, 0xaa, 0xe1, 0x62, 0x83, 0xa8, 0x10, 0x52, 0xc6, 0xd0, 0x8a, 0xe9, 0x0c, 0x29,
-	0x15, 0xfc, 0x8a, 0x20, 0xae, 0x52, 0x52, 0x6f, 0xba, 0xfc, 0x64, 0x32, 0x93, 0xa2, 0x90, 0xbc,
-	0x3e, 0x58, 0xb5, 0x3e, 0x22, 0x78, 0xca, 0x0c, 0x93, 0x52, 0x4b, 0x12, 0x0d, 0xf5, 0x21, 0xee,
-	0x70, 0xf2, 0x39, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27,
-	0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xa3, 0xf4, 0xcc,
-	0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x88, 0x21, 0xba, 0x89, 0xc5, 0xc5, 0xa9, 0x25,
-	0xc5, 0x50, 0x13, 0xcb, 0x4c, 0xf5, 0x2b, 0x90, 0x8c, 0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62,
-	0x03, 0x07, 0xa0, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x47, 0xe1, 0x7b, 0xb7, 0x01, 0x00,
-	0x00,
+	// 829 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6e, 0xeb, 0x44,
+	0x14, 0xc6, 0xe3, 0xb4, 0xa4, 0xed, 0x24, 0x17, 0xd0, 0xdc, 0x94, 0x9b, 0x86, 0x2a, 0x49, 0xdd,
+	0x52, 0xd2, 0x56, 0xb5, 0x9b, 0x44, 0x95, 0x80, 0x65, 0xa0, 0xed, 0xa6, 0x45, 0xc5, 0x82, 0x0d,
+	0x1b, 0x6b, 0x9c, 0x9e, 0x98, 0x11, 0xb6, 0xc7, 0xf5, 0x38, 0x51, 0x23, 0x81, 0x50, 0x79, 0x02,
+	0x24, 0x36, 0xb0, 0x46, 0x6c, 0x58, 0xb3, 0xe3, 0x05, 0x2a, 0x56, 0x95, 0xd8, 0x20, 0x16, 0x05,
+	0xb5, 0x3c, 0x08, 0xf2, 0x78, 0xf2, 0xaf, 0xb1, 0x4b, 0x2b, 0x21, 0x74, 0x57, 0xf1, 0x8c, 0xe7,
+	0x9c, 0xf3, 0xf3, 0x37, 0xe7, 0x7c, 0x0a, 0x5a, 0xb6, 0x1d, 0x66, 0x11, 0xa7, 0x0b, 0xa0, 0x9f,
+	0xf7, 0x20, 0x18, 0x68, 0x7e, 0xc0, 0x42, 0x86, 0x5f, 0xf3, 0x98, 0xe5, 0x80, 0x36, 0x7a, 0x59,
+	0xae, 0x74, 0x18, 0x77, 0x19, 0xd7, 0x2d, 0xc2, 0x41, 0xef, 0x37, 0x2c, 0x08, 0x49, 0x43, 0xef,
+	0x30, 0xea, 0xc5, 0x01, 0xe5, 0x17, 0xe3, 0x3c, 0x36, 0x78, 0xc0, 0x29, 0x97, 0x2f, 0x8a, 0x36,
+	0xb3, 0x99, 0x78, 0xd4, 0xa3, 0x27, 0xb9, 0xbb, 0x6a, 0x33, 0x66, 0x3b, 0xa0, 0x13, 0x9f, 0xea,
+	0xc4, 0xf3, 0x58, 0x48, 0x42, 0xca, 0x3c, 0x19, 0xa3, 0x16, 0x11, 0xfe, 0x28, 0x82, 0x39, 0x25,
+	0x01, 0x71, 0xb9, 0x01, 0xe7, 0x3d, 0xe0, 0xa1, 0x7a, 0x8c, 0x9e, 0x4f, 0xed, 0x72, 0x9f, 0x79,
+	0x1c, 0xf0, 0x3e, 0xca, 0xf9, 0x62, 0xa7, 0xa4, 0xd4, 0x94, 0x7a, 0xbe, 0xf9, 0x42, 0xbb, 0xc7,
+	0xae, 0xc5, 0x01, 0xed, 0xf9, 0xab, 0x9b, 0x6a, 0xc6, 0x90, 0x87, 0xd5, 0x75, 0xb4, 0x26, 0xb2,
+	0x1d, 0x74, 0xbb, 0xd0, 0x09, 0x69, 0x1f, 0x4e, 0xa8, 0x77, 0x44, 0xf8, 0x69, 0x40, 0x3b, 0x30,
+	0x2a, 0xf9, 0xa3, 0x82, 0xd4, 0x87, 0x4e, 0x49, 0x84, 0xaf, 0x10, 0x76, 0xa9, 0x47, 0xdd, 0x9e,
+	0x6b, 0xda, 0x84, 0x9b, 0xbe, 0x78, 0x5b, 0x52, 0x6a, 0x73, 0xf5, 0x7c, 0x73, 0x55, 0x8b, 0x95,
+	0xd3, 0x22, 0xe5, 0x34, 0xa9, 0x9c, 0xf6, 0x01, 0x74, 0xde, 0x67, 0xd4, 0x6b, 0xb7, 0x22, 0xa6,
+	0x9f, 0xfe, 0xac, 0xee, 0xd8, 0x34, 0xfc, 0xac, 0x67, 0x69, 0x1d, 0xe6, 0xea, 0x52, 0xe9, 0xf8,
+	0x67, 0x97, 0x9f, 0x7d, 0xae, 0x87, 0x03, 0x1f, 0xf8, 0x30, 0x86, 0x1b, 0xaf, 0xcb, 0x62, 0x23,
+	0x10, 0xf5, 0x00, 0x55, 0x05, 0xe6, 0x09, 0xb7, 0x3f, 0x1e, 0xf8, 0x49, 0x9f, 0x82, 0x55, 0xf4,
+	0xcc, 0xe5, 0xb6, 0x19, 0xa5, 0x32, 0x7b, 0x81, 0x13, 0xe3, 0x2d, 0x19, 0x79, 0x37, 0x0e, 0xf9,
+	0x24, 0x70, 0xb8, 0xfa, 0x83, 0x82, 0x6a, 0xe9, 0x79, 0x5e, 0x96, 0x8f, 0x5d, 0x96, 0x7d, 0xd0,
+	0x26, 0x1c, 0x0e, 0x01, 0x86, 0x77, 0x75, 0x99, 0x45, 0xc5, 0xe9, 0x7d, 0x09, 0x5c, 0x42, 0x0b,
+	0xe0, 0x11, 0xcb, 0x81, 0x33, 0xd1, 0x21, 0x8b, 0xc6, 0x70, 0x89, 0x3f, 0x44, 0xc8, 0xed, 0x39,
+	0x21, 0xf5, 0x1d, 0x0a, 0x41, 0x29, 0x5b, 0x53, 0xea, 0x4b, 0x6d, 0x2d, 0x82, 0xfc, 0xe3, 0xa6,
+	0xba, 0xf9, 0x38, 0x48, 0x63, 0x22, 0x43, 0x8a, 0x34, 0x73, 0xff, 0x9f, 0x34, 0x7d, 0x54, 0x12,
+	0x12, 0x44, 0x9a, 0xd0, 0x00, 0xce, 0x0e, 0x61, 0xdc, 0x00, 0x2b, 0x68, 0x31, 0xbc, 0x30, 0xad,
+	0x41, 0x08, 0xf1, 0xa4, 0x14, 0x8c, 0x85, 0xf0, 0xa2, 0x1d, 0x2d, 0x67, 0x7b, 0x23, 0x3b, 0xd3,
+	0x1b, 0xf8, 0x4d, 0xb4, 0x14, 0x7d, 0x93, 0x43, 0x5d, 0x1a, 0x96, 0xe6, 0x6a, 0x4a, 0x7d, 0xde,
+	0x58, 0xb4, 0x09, 0x3f, 0x8e, 0xd6, 0xea, 0xaf, 0x59, 0xb4, 0x92, 0x50, 0x58, 0x5e, 0xc0, 0x06,
+	0x7a, 0xd5, 0x1a, 0xf8, 0x84, 0x73, 0xd3, 0xa5, 0x9e, 0xd9, 0x05, 0x90, 0xf7, 0x50, 0x88, 0x77,
+	0x4f, 0xa8, 0x77, 0x08, 0x80, 0xbf, 0x44, 0xc5, 0x40, 0x46, 0x9b, 0xf1, 0xec, 0x46, 0x47, 0x63,
+	0x96, 0x7c, 0x73, 0x25, 0x51, 0x3e, 0xa1, 0xdd, 0x9e, 0xd4, 0xae, 0xfe, 0x08, 0xed, 0x62, 0xe1,
+	0xf0, 0xb0, 0xd0, 0x91, 0xa8, 0x13, 0xc1, 0x62, 0x1f, 0x3d, 0x1b, 0x95, 0xef, 0xc2, 0xe8, 0xda,
+	0xfe, 0xd3, 0xba, 0x85, 0x60, 0x42, 0x1e, 0xfc, 0x06, 0xca, 0x05, 0x40, 0x38, 0xf3, 0x4a, 0xf3,
+	0x51, 0xe7, 0x19, 0x72, 0xd5, 0xfc, 0x3e, 0x87, 0x5e, 0x11, 0x62, 0xe2, 0x2f, 0x50, 0x2e, 0xf6,
+	0x2e, 0xbc, 0x3e, 0x63, 0x6a, 0xb3, 0x06, 0x59, 0xde, 0x78, 0xf8, 0x50, 0x7c, 0x1b, 0xea, 0xdb,
+	0x5f, 0xff, 0xf6, 0xf7, 0xb7, 0xd9, 0x35, 0x5c, 0xd5, 0xc5, 0x69, 0x7d, 0x6c, 0xdc, 0x43, 0x5b,
+	0x8f, 0x1d, 0x12, 0xff, 0xa2, 0xa0, 0xe5, 0x44, 0xdf, 0xc3, 0xcd, 0xe4, 0x42, 0x0f, 0x59, 0x69,
+	0xb9, 0xf5, 0xa4, 0x18, 0xc9, 0xfa, 0xae, 0x60, 0x6d, 0xe1, 0x46, 0x2a, 0x2b, 0x0c, 0xe3, 0x45,
+	0x6f, 0x8d, 0xa7, 0x0e, 0xff, 0xac, 0xa0, 0xe7, 0x09, 0x36, 0x86, 0xf7, 0x92, 0x39, 0xd2, 0x9d,
+	0xb3, 0xdc, 0x78, 0x42, 0x84, 0xe4, 0x7e, 0x47, 0x70, 0x37, 0xf1, 0x5e, 0x2a, 0xf7, 0x68, 0xde,
+	0xee, 0x61, 0x5f, 0x2a, 0x68, 0x41, 0x1a, 0x18, 0x4e, 0xb9, 0xcf, 0x69, 0xdf, 0x2b, 0xbf, 0xf5,
+	0x2f, 0xa7, 0x24, 0xd2, 0x96, 0x40, 0x5a, 0xc7, 0x6b, 0xa9, 0x48, 0x51, 0x87, 0x47, 0xad, 0x8f,
+	0xbf, 0x53, 0x50, 0x61, 0x72, 0x90, 0xf1, 0x56, 0x72, 0x89, 0x04, 0x97, 0x29, 0x6f, 0x3f, 0xe6,
+	0xa8, 0x44, 0x6a, 0x08, 0xa4, 0x9d, 0xf7, 0x94, 0x6d, 0x75, 0x33, 0x95, 0x6a, 0x6a, 0x28, 0xdb,
+	0xc7, 0x57, 0xb7, 0x15, 0xe5, 0xfa, 0xb6, 0xa2, 0xfc, 0x75, 0x5b, 0x51, 0xbe, 0xb9, 0xab, 0x64,
+	0xae, 0xef, 0x2a, 0x99, 0xdf, 0xef, 0x2a, 0x99, 0x4f, 0x9b, 0x13, 0x53, 0x28, 0x72, 0xed, 0x12,
+	0xce, 0x21, 0xe4, 0x32, 0x71, 0x7f, 0x5f, 0xbf, 0x98, 0xc8, 0x2e, 0xa6, 0xd2, 0xca, 0x89, 0xbf,
+	0x1b, 0xad, 0x7f, 0x06, 0x00, 0xcb, 0x17, 0xd2, 0x6d, 0x05, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -154,6 +620,10 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	EffectiveMinGasPrices(ctx context.Context, in *QueryEffectiveMinGasPricesRequest, opts ...grpc.CallOption) (*QueryEffectiveMinGasPricesResponse, error)
+	MsgTypeMinGasPrices(ctx context.Context, in *QueryMsgTypeMinGasPricesRequest, opts ...grpc.CallOption) (*QueryMsgTypeMinGasPricesResponse, error)
+	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
+	RequiredFees(ctx context.Context, in *QueryRequiredFeesRequest, opts ...grpc.CallOption) (*QueryRequiredFeesResponse, error)
 }
 
 type queryClient struct {
@@ -173,9 +643,49 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) EffectiveMinGasPrices(ctx context.Context, in *QueryEffectiveMinGasPricesRequest, opts ...grpc.CallOption) (*QueryEffectiveMinGasPricesResponse, error) {
+	out := new(QueryEffectiveMinGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/noble.globalfee.Query/EffectiveMinGasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MsgTypeMinGasPrices(ctx context.Context, in *QueryMsgTypeMinGasPricesRequest, opts ...grpc.CallOption) (*QueryMsgTypeMinGasPricesResponse, error) {
+	out := new(QueryMsgTypeMinGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/noble.globalfee.Query/MsgTypeMinGasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
+	out := new(QueryBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/noble.globalfee.Query/BaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RequiredFees(ctx context.Context, in *QueryRequiredFeesRequest, opts ...grpc.CallOption) (*QueryRequiredFeesResponse, error) {
+	out := new(QueryRequiredFeesResponse)
+	err := c.cc.Invoke(ctx, "/noble.globalfee.Query/RequiredFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	EffectiveMinGasPrices(context.Context, *QueryEffectiveMinGasPricesRequest) (*QueryEffectiveMinGasPricesResponse, error)
+	MsgTypeMinGasPrices(context.Context, *QueryMsgTypeMinGasPricesRequest) (*QueryMsgTypeMinGasPricesResponse, error)
+	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
+	RequiredFees(context.Context, *QueryRequiredFeesRequest) (*QueryRequiredFeesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -185,6 +695,18 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) EffectiveMinGasPrices(ctx context.Context, req *QueryEffectiveMinGasPricesRequest) (*QueryEffectiveMinGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveMinGasPrices not implemented")
+}
+func (*UnimplementedQueryServer) MsgTypeMinGasPrices(ctx context.Context, req *QueryMsgTypeMinGasPricesRequest) (*QueryMsgTypeMinGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgTypeMinGasPrices not implemented")
+}
+func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
+}
+func (*UnimplementedQueryServer) RequiredFees(ctx context.Context, req *QueryRequiredFeesRequest) (*QueryRequiredFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequiredFees not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -208,14 +730,102 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "noble.globalfee.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
+func _Query_EffectiveMinGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveMinGasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveMinGasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.globalfee.Query/EffectiveMinGasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveMinGasPrices(ctx, req.(*QueryEffectiveMinGasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MsgTypeMinGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgTypeMinGasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgTypeMinGasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.globalfee.Query/MsgTypeMinGasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgTypeMinGasPrices(ctx, req.(*QueryMsgTypeMinGasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.globalfee.Query/BaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RequiredFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequiredFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RequiredFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.globalfee.Query/RequiredFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RequiredFees(ctx, req.(*QueryRequiredFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.globalfee.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "EffectiveMinGasPrices",
+			Handler:    _Query_EffectiveMinGasPrices_Handler,
+		},
+		{
+			MethodName: "MsgTypeMinGasPrices",
+			Handler:    _Query_MsgTypeMinGasPrices_Handler,
+		},
+		{
+			MethodName: "BaseFee",
+			Handler:    _Query_BaseFee_Handler,
+		},
+		{
+			MethodName: "RequiredFees",
+			Handler:    _Query_RequiredFees_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "globalfee/query.proto",
@@ -269,52 +879,1127 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if err != nil {
 			return 0, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveMinGasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveMinGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveMinGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveMinGasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveMinGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveMinGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMsgTypeMinGasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgTypeMinGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgTypeMinGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMsgTypeMinGasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgTypeMinGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgTypeMinGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequiredFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequiredFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequiredFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequiredFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequiredFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequiredFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RequiredFees) > 0 {
+		for iNdEx := len(m.RequiredFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequiredFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RequiredGlobalFees) > 0 {
+		for iNdEx := len(m.RequiredGlobalFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequiredGlobalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BypassMinFee {
+		i--
+		if m.BypassMinFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEffectiveMinGasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEffectiveMinGasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMsgTypeMinGasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMsgTypeMinGasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRequiredFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *QueryRequiredFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BypassMinFee {
+		n += 2
+	}
+	if len(m.RequiredGlobalFees) > 0 {
+		for _, e := range m.RequiredGlobalFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RequiredFees) > 0 {
+		for _, e := range m.RequiredFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveMinGasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveMinGasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveMinGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveMinGasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveMinGasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveMinGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMsgTypeMinGasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgTypeMinGasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgTypeMinGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMsgTypeMinGasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgTypeMinGasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgTypeMinGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRequiredFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -337,12 +2022,97 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRequiredFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRequiredFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -364,7 +2134,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRequiredFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -387,15 +2157,35 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRequiredFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRequiredFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassMinFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BypassMinFee = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredGlobalFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -422,10 +2212,77 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RequiredGlobalFees = append(m.RequiredGlobalFees, types.Coin{})
+			if err := m.RequiredGlobalFees[len(m.RequiredGlobalFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredFees = append(m.RequiredFees, types.Coin{})
+			if err := m.RequiredFees[len(m.RequiredFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
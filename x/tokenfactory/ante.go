@@ -0,0 +1,380 @@
+package tokenfactory
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// IsPausedDecorator rejects transfers of a minting denom while it is paused,
+// including the transfers nested in authz MsgExec.
+type IsPausedDecorator struct {
+	tokenFactory *keeper.Keeper
+}
+
+func NewIsPausedDecorator(tf *keeper.Keeper) IsPausedDecorator {
+	return IsPausedDecorator{
+		tokenFactory: tf,
+	}
+}
+
+func (ad IsPausedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	msgs := tx.GetMsgs()
+
+	err = ad.CheckMessages(ctx, msgs)
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (ad IsPausedDecorator) CheckMessages(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch m := msg.(type) {
+		case *authz.MsgExec:
+			nestedMsgs, err := m.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := ad.CheckMessages(ctx, nestedMsgs); err != nil {
+				return err
+			}
+		case *banktypes.MsgSend:
+			for _, c := range m.Amount {
+				if err := checkPausedStateByTokenFactory(ctx, c, ad.tokenFactory); err != nil {
+					return err
+				}
+			}
+		case *banktypes.MsgMultiSend:
+			for _, i := range m.Inputs {
+				for _, c := range i.Coins {
+					if err := checkPausedStateByTokenFactory(ctx, c, ad.tokenFactory); err != nil {
+						return err
+					}
+				}
+			}
+		case *transfertypes.MsgTransfer:
+			if err := checkPausedStateByTokenFactory(ctx, m.Token, ad.tokenFactory); err != nil {
+				return err
+			}
+		default:
+			continue
+		}
+	}
+
+	return nil
+}
+
+// checkPausedStateByTokenFactory returns ErrPaused if c is a minting denom
+// and it is paused.
+func checkPausedStateByTokenFactory(ctx sdk.Context, c sdk.Coin, tf *keeper.Keeper) error {
+	if tf.HasMintingDenom(ctx, c.Denom) && tf.GetPaused(ctx, c.Denom).Paused {
+		return sdkerrors.Wrapf(types.ErrPaused, "can not perform token transfers")
+	}
+	return nil
+}
+
+// IsBlacklistedDecorator rejects transfers of a minting denom from, to or on
+// behalf of addresses blacklisted for it, including the transfers nested in authz
+// MsgExec, whose grantee is checked as well.
+type IsBlacklistedDecorator struct {
+	tokenFactory *keeper.Keeper
+}
+
+func NewIsBlacklistedDecorator(tf *keeper.Keeper) IsBlacklistedDecorator {
+	return IsBlacklistedDecorator{
+		tokenFactory: tf,
+	}
+}
+
+func (ad IsBlacklistedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	msgs := tx.GetMsgs()
+
+	err = ad.CheckMessages(ctx, msgs, nil)
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (ad IsBlacklistedDecorator) CheckMessages(ctx sdk.Context, msgs []sdk.Msg, grantee *string) error {
+	for _, msg := range msgs {
+		switch m := msg.(type) {
+		case *authz.MsgExec:
+			nestedMsgs, err := m.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := ad.CheckMessages(ctx, nestedMsgs, &m.Grantee); err != nil {
+				return err
+			}
+		case *banktypes.MsgSend:
+			for _, c := range m.Amount {
+				if err := ad.checkGrantee(ctx, grantee, c); err != nil {
+					return err
+				}
+				if err := ad.checkAddress(ctx, m.ToAddress, c, "can not receive tokens"); err != nil {
+					return err
+				}
+				if err := ad.checkAddress(ctx, m.FromAddress, c, "can not send tokens"); err != nil {
+					return err
+				}
+			}
+		case *banktypes.MsgMultiSend:
+			for _, i := range m.Inputs {
+				for _, c := range i.Coins {
+					if err := ad.checkGrantee(ctx, grantee, c); err != nil {
+						return err
+					}
+					if err := ad.checkAddress(ctx, i.Address, c, "can not send or receive tokens"); err != nil {
+						return err
+					}
+				}
+			}
+			for _, o := range m.Outputs {
+				for _, c := range o.Coins {
+					if err := ad.checkGrantee(ctx, grantee, c); err != nil {
+						return err
+					}
+					if err := ad.checkAddress(ctx, o.Address, c, "can not send or receive tokens"); err != nil {
+						return err
+					}
+				}
+			}
+		case *transfertypes.MsgTransfer:
+			if err := ad.checkGrantee(ctx, grantee, m.Token); err != nil {
+				return err
+			}
+			if err := ad.checkAddress(ctx, m.Sender, m.Token, "can not send tokens"); err != nil {
+				return err
+			}
+			if err := ad.checkAddress(ctx, m.Receiver, m.Token, "can not receive tokens"); err != nil {
+				return err
+			}
+		default:
+			continue
+		}
+	}
+
+	return nil
+}
+
+// checkGrantee checks the grantee executing the msg, if any.
+func (ad IsBlacklistedDecorator) checkGrantee(ctx sdk.Context, grantee *string, c sdk.Coin) error {
+	if grantee == nil {
+		return nil
+	}
+	return ad.checkAddress(ctx, *grantee, c, "can not execute transfers on behalf of others")
+}
+
+// checkAddress wraps the error of checkForBlacklistedAddressByTokenFactory
+// with what address is not allowed to do.
+func (ad IsBlacklistedDecorator) checkAddress(ctx sdk.Context, address string, c sdk.Coin, action string) error {
+	err := checkForBlacklistedAddressByTokenFactory(ctx, address, c, ad.tokenFactory)
+	if errors.Is(err, types.ErrUnauthorized) {
+		return sdkerrors.Wrapf(err, "an address (%s) is blacklisted and %s", address, action)
+	} else if err != nil {
+		return sdkerrors.Wrapf(err, "error decoding address (%s)", address)
+	}
+	return nil
+}
+
+// checkForBlacklistedAddressByTokenFactory first checks if the denom being transacted is a minting denom of the
+// tokenfactory, if it is, it checks if the address involved in the tx is blacklisted for it.
+func checkForBlacklistedAddressByTokenFactory(ctx sdk.Context, address string, c sdk.Coin, tf *keeper.Keeper) error {
+	if !tf.HasMintingDenom(ctx, c.Denom) {
+		return nil
+	}
+	_, addressBz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return err
+	}
+	if _, found := tf.GetBlacklisted(ctx, c.Denom, addressBz); found {
+		return types.ErrUnauthorized
+	}
+	return nil
+}
+
+// IsBlacklistedFeeDecorator rejects fees paid in a minting denom by a fee
+// payer or fee granter blacklisted for it, before DeductFeeDecorator moves
+// them. If the RejectBlacklistedSigners param is set, it also rejects every
+// tx signed by an address blacklisted for any minting denom.
+type IsBlacklistedFeeDecorator struct {
+	tokenFactory *keeper.Keeper
+}
+
+func NewIsBlacklistedFeeDecorator(tf *keeper.Keeper) IsBlacklistedFeeDecorator {
+	return IsBlacklistedFeeDecorator{
+		tokenFactory: tf,
+	}
+}
+
+func (ad IsBlacklistedFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if err := ad.CheckFee(ctx, feeTx); err != nil {
+		return ctx, err
+	}
+	if err := ad.CheckSigners(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// CheckFee returns ErrUnauthorized if the fee contains a minting denom and
+// either the fee payer or the fee granter is blacklisted for it.
+func (ad IsBlacklistedFeeDecorator) CheckFee(ctx sdk.Context, feeTx sdk.FeeTx) error {
+	for _, coin := range feeTx.GetFee() {
+		denom := coin.Denom
+		if coin.IsZero() || !ad.tokenFactory.HasMintingDenom(ctx, denom) {
+			continue
+		}
+
+		if _, found := ad.tokenFactory.GetBlacklisted(ctx, denom, feeTx.FeePayer()); found {
+			return sdkerrors.Wrapf(types.ErrUnauthorized, "an address (%s) is blacklisted and can not pay fees", feeTx.FeePayer())
+		}
+		if granter := feeTx.FeeGranter(); granter != nil {
+			if _, found := ad.tokenFactory.GetBlacklisted(ctx, denom, granter); found {
+				return sdkerrors.Wrapf(types.ErrUnauthorized, "an address (%s) is blacklisted and can not grant fees", granter)
+			}
+		}
+	}
+	return nil
+}
+
+// CheckSigners returns ErrUnauthorized if the RejectBlacklistedSigners param
+// is set and any signer of msgs is blacklisted for any minting denom.
+func (ad IsBlacklistedFeeDecorator) CheckSigners(ctx sdk.Context, msgs []sdk.Msg) error {
+	if !ad.tokenFactory.RejectBlacklistedSigners(ctx) {
+		return nil
+	}
+
+	mintingDenoms := ad.tokenFactory.GetAllMintingDenoms(ctx)
+	for _, msg := range msgs {
+		for _, signer := range msg.GetSigners() {
+			for _, mintingDenom := range mintingDenoms {
+				if _, found := ad.tokenFactory.GetBlacklisted(ctx, mintingDenom.Denom, signer); found {
+					return sdkerrors.Wrapf(types.ErrUnauthorized, "an address (%s) is blacklisted and can not sign transactions", signer)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// TravelRuleDecorator requires a travel rule memo on every MsgSend and
+// MsgTransfer, including those nested in authz MsgExec, moving more than the
+// travel rule threshold of a minting denom, and emits the parsed reference
+// for each of them. It does nothing unless the TravelRuleEnabled param is set.
+type TravelRuleDecorator struct {
+	tokenFactory *keeper.Keeper
+}
+
+func NewTravelRuleDecorator(tf *keeper.Keeper) TravelRuleDecorator {
+	return TravelRuleDecorator{
+		tokenFactory: tf,
+	}
+}
+
+func (ad TravelRuleDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	memoTx, ok := tx.(sdk.TxWithMemo)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	err = ad.CheckMessages(ctx, tx.GetMsgs(), memoTx.GetMemo())
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (ad TravelRuleDecorator) CheckMessages(ctx sdk.Context, msgs []sdk.Msg, memo string) error {
+	if !ad.tokenFactory.TravelRuleEnabled(ctx) {
+		return nil
+	}
+	checker := travelRuleChecker{
+		tokenFactory: ad.tokenFactory,
+		threshold:    ad.tokenFactory.TravelRuleThreshold(ctx),
+		memo:         memo,
+	}
+	return checker.checkMessages(ctx, msgs)
+}
+
+// travelRuleChecker parses the memo of a tx the first time one of its msgs
+// needs it.
+type travelRuleChecker struct {
+	tokenFactory *keeper.Keeper
+	threshold    sdk.Int
+	memo         string
+
+	reference *types.TravelRuleReference
+}
+
+func (c *travelRuleChecker) checkMessages(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch m := msg.(type) {
+		case *authz.MsgExec:
+			nestedMsgs, err := m.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := c.checkMessages(ctx, nestedMsgs); err != nil {
+				return err
+			}
+		case *banktypes.MsgSend:
+			for _, amount := range m.Amount {
+				if err := c.checkTransfer(ctx, m.FromAddress, m.ToAddress, amount); err != nil {
+					return err
+				}
+			}
+		case *transfertypes.MsgTransfer:
+			if err := c.checkTransfer(ctx, m.Sender, m.Receiver, m.Token); err != nil {
+				return err
+			}
+		default:
+			continue
+		}
+	}
+
+	return nil
+}
+
+// checkTransfer requires the travel rule memo if amount is in a minting denom
+// and above the threshold, and emits its reference.
+func (c *travelRuleChecker) checkTransfer(ctx sdk.Context, sender, recipient string, amount sdk.Coin) error {
+	if amount.Amount.LTE(c.threshold) || !c.tokenFactory.HasMintingDenom(ctx, amount.Denom) {
+		return nil
+	}
+	if c.reference == nil {
+		reference, err := types.ParseTravelRuleMemo(c.memo)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "transfer of %s is above the threshold of %s%s", amount, c.threshold, amount.Denom)
+		}
+		c.reference = &reference
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventTravelRule{
+		Sender:          sender,
+		Recipient:       recipient,
+		Amount:          amount,
+		Reference:       c.reference.Reference,
+		OriginatorVasp:  c.reference.OriginatorVASP,
+		BeneficiaryVasp: c.reference.BeneficiaryVASP,
+	})
+}
@@ -0,0 +1,214 @@
+package tokenfactory_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+const mintingDenom = "utoken"
+
+func TestIsBlacklistedDecorator(t *testing.T) {
+	tf, ctx := keepertest.TokenfactoryKeeper(t)
+	tf.SetMintingDenom(ctx, types.MintingDenom{Denom: mintingDenom})
+	tf.SetPaused(ctx, mintingDenom, types.Paused{Paused: false})
+
+	blacklisted := sample.TestAccount()
+	tf.SetBlacklisted(ctx, mintingDenom, types.Blacklisted{AddressBz: blacklisted.AddressBz})
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(mintingDenom, 1))
+	otherCoins := sdk.NewCoins(sdk.NewInt64Coin("uother", 1))
+	send := func(from, to string, amount sdk.Coins) *banktypes.MsgSend {
+		return &banktypes.MsgSend{FromAddress: from, ToAddress: to, Amount: amount}
+	}
+	exec := func(grantee string, msgs ...sdk.Msg) *authz.MsgExec {
+		msg := authz.NewMsgExec(sdk.MustAccAddressFromBech32(grantee), msgs)
+		return &msg
+	}
+
+	tests := map[string]struct {
+		msgs   []sdk.Msg
+		expErr bool
+		errMsg string
+	}{
+		"send": {
+			msgs: []sdk.Msg{send(alice, bob, coins)},
+		},
+		"send from blacklisted": {
+			msgs:   []sdk.Msg{send(blacklisted.Address, bob, coins)},
+			expErr: true,
+		},
+		"send to blacklisted": {
+			msgs:   []sdk.Msg{send(alice, blacklisted.Address, coins)},
+			expErr: true,
+		},
+		"send other denom from blacklisted": {
+			msgs: []sdk.Msg{send(blacklisted.Address, bob, otherCoins)},
+		},
+		"multi send to blacklisted": {
+			msgs: []sdk.Msg{&banktypes.MsgMultiSend{
+				Inputs:  []banktypes.Input{banktypes.NewInput(sdk.MustAccAddressFromBech32(alice), coins)},
+				Outputs: []banktypes.Output{banktypes.NewOutput(blacklisted.AddressBz, coins)},
+			}},
+			expErr: true,
+		},
+		"transfer from blacklisted": {
+			msgs: []sdk.Msg{transfertypes.NewMsgTransfer(
+				transfertypes.PortID, "channel-0", coins[0], blacklisted.Address, bob, clienttypes.NewHeight(1, 1), 0,
+			)},
+			expErr: true,
+		},
+		"exec by blacklisted grantee": {
+			msgs:   []sdk.Msg{exec(blacklisted.Address, send(alice, bob, coins))},
+			expErr: true,
+			errMsg: "can not execute transfers on behalf of others",
+		},
+		"exec of send from blacklisted": {
+			msgs:   []sdk.Msg{exec(alice, send(blacklisted.Address, bob, coins))},
+			expErr: true,
+		},
+		"nested exec of send to blacklisted": {
+			msgs:   []sdk.Msg{exec(alice, exec(bob, send(alice, blacklisted.Address, coins)))},
+			expErr: true,
+		},
+		"exec followed by send from blacklisted": {
+			msgs:   []sdk.Msg{exec(alice, send(alice, bob, coins)), send(blacklisted.Address, bob, coins)},
+			expErr: true,
+		},
+	}
+
+	decorator := tokenfactory.NewIsBlacklistedDecorator(tf)
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := decorator.CheckMessages(ctx, test.msgs, nil)
+			if test.expErr {
+				require.ErrorIs(t, err, types.ErrUnauthorized)
+				if test.errMsg != "" {
+					require.ErrorContains(t, err, test.errMsg)
+				}
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestIsPausedDecorator(t *testing.T) {
+	tf, ctx := keepertest.TokenfactoryKeeper(t)
+	tf.SetMintingDenom(ctx, types.MintingDenom{Denom: mintingDenom})
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(mintingDenom, 1))
+	send := &banktypes.MsgSend{FromAddress: alice, ToAddress: bob, Amount: coins}
+	otherSend := &banktypes.MsgSend{FromAddress: alice, ToAddress: bob, Amount: sdk.NewCoins(sdk.NewInt64Coin("uother", 1))}
+	exec := authz.NewMsgExec(sdk.MustAccAddressFromBech32(bob), []sdk.Msg{send})
+	transfer := transfertypes.NewMsgTransfer(transfertypes.PortID, "channel-0", coins[0], alice, bob, clienttypes.NewHeight(1, 1), 0)
+	decorator := tokenfactory.NewIsPausedDecorator(tf)
+
+	tf.SetPaused(ctx, mintingDenom, types.Paused{Paused: false})
+	require.NoError(t, decorator.CheckMessages(ctx, []sdk.Msg{send, &exec, transfer}))
+
+	tf.SetPaused(ctx, mintingDenom, types.Paused{Paused: true})
+	require.NoError(t, decorator.CheckMessages(ctx, []sdk.Msg{otherSend}))
+	require.ErrorIs(t, decorator.CheckMessages(ctx, []sdk.Msg{send}), types.ErrPaused)
+	require.ErrorIs(t, decorator.CheckMessages(ctx, []sdk.Msg{&exec}), types.ErrPaused)
+	require.ErrorIs(t, decorator.CheckMessages(ctx, []sdk.Msg{otherSend, transfer}), types.ErrPaused)
+}
+
+// feeTx is a sdk.FeeTx carrying only a fee, its payer and granter.
+type feeTx struct {
+	sdk.FeeTx
+	fee     sdk.Coins
+	payer   sdk.AccAddress
+	granter sdk.AccAddress
+}
+
+func (tx feeTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx feeTx) FeePayer() sdk.AccAddress   { return tx.payer }
+func (tx feeTx) FeeGranter() sdk.AccAddress { return tx.granter }
+
+func TestIsBlacklistedFeeDecorator(t *testing.T) {
+	tf, ctx := keepertest.TokenfactoryKeeper(t)
+	decorator := tokenfactory.NewIsBlacklistedFeeDecorator(tf)
+
+	blacklisted := sample.TestAccount()
+	alice := sdk.AccAddress(sample.AddressBz())
+	fee := sdk.NewCoins(sdk.NewInt64Coin(mintingDenom, 1))
+	otherFee := sdk.NewCoins(sdk.NewInt64Coin("uother", 1))
+	tf.SetBlacklisted(ctx, mintingDenom, types.Blacklisted{AddressBz: blacklisted.AddressBz})
+
+	// nothing is restricted before the minting denom is set
+	require.NoError(t, decorator.CheckFee(ctx, feeTx{fee: fee, payer: blacklisted.AddressBz}))
+
+	tf.SetMintingDenom(ctx, types.MintingDenom{Denom: mintingDenom})
+	require.NoError(t, decorator.CheckFee(ctx, feeTx{fee: fee, payer: alice}))
+	require.NoError(t, decorator.CheckFee(ctx, feeTx{fee: otherFee, payer: blacklisted.AddressBz}))
+	require.NoError(t, decorator.CheckFee(ctx, feeTx{fee: otherFee, payer: alice, granter: blacklisted.AddressBz}))
+	require.ErrorIs(t, decorator.CheckFee(ctx, feeTx{fee: fee, payer: blacklisted.AddressBz}), types.ErrUnauthorized)
+	require.ErrorIs(t, decorator.CheckFee(ctx, feeTx{fee: fee.Add(otherFee...), payer: alice, granter: blacklisted.AddressBz}), types.ErrUnauthorized)
+
+	send := &banktypes.MsgSend{FromAddress: blacklisted.Address, ToAddress: sample.AccAddress(), Amount: otherFee}
+	require.NoError(t, decorator.CheckSigners(ctx, []sdk.Msg{send}))
+
+	params := types.DefaultParams()
+	params.RejectBlacklistedSigners = true
+	tf.SetParams(ctx, params)
+	require.ErrorIs(t, decorator.CheckSigners(ctx, []sdk.Msg{send}), types.ErrUnauthorized)
+	send.FromAddress = alice.String()
+	require.NoError(t, decorator.CheckSigners(ctx, []sdk.Msg{send}))
+}
+
+func TestTravelRuleDecorator(t *testing.T) {
+	tf, ctx := keepertest.TokenfactoryKeeper(t)
+	tf.SetMintingDenom(ctx, types.MintingDenom{Denom: mintingDenom})
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+	decorator := tokenfactory.NewTravelRuleDecorator(tf)
+
+	above := sdk.NewCoins(sdk.NewInt64Coin(mintingDenom, 101))
+	send := &banktypes.MsgSend{FromAddress: alice, ToAddress: bob, Amount: above}
+	belowSend := &banktypes.MsgSend{FromAddress: alice, ToAddress: bob, Amount: sdk.NewCoins(sdk.NewInt64Coin(mintingDenom, 100))}
+	otherSend := &banktypes.MsgSend{FromAddress: alice, ToAddress: bob, Amount: sdk.NewCoins(sdk.NewInt64Coin("uother", 101))}
+	exec := authz.NewMsgExec(sdk.MustAccAddressFromBech32(bob), []sdk.Msg{send})
+	transfer := transfertypes.NewMsgTransfer(transfertypes.PortID, "channel-0", above[0], alice, bob, clienttypes.NewHeight(1, 1), 0)
+	memo := `{"travel_rule":{"reference":"tr-1","originator_vasp":"vasp-a"}}`
+
+	// disabled by default
+	require.NoError(t, decorator.CheckMessages(ctx, []sdk.Msg{send}, ""))
+
+	params := types.DefaultParams()
+	params.TravelRuleEnabled = true
+	params.TravelRuleThreshold = sdk.NewInt(100)
+	tf.SetParams(ctx, params)
+
+	require.NoError(t, decorator.CheckMessages(ctx, []sdk.Msg{belowSend, otherSend}, ""))
+	for _, msg := range []sdk.Msg{send, &exec, transfer} {
+		require.ErrorIs(t, decorator.CheckMessages(ctx, []sdk.Msg{msg}, ""), types.ErrTravelRule)
+		require.ErrorIs(t, decorator.CheckMessages(ctx, []sdk.Msg{msg}, `{"travel_rule":{}}`), types.ErrTravelRule)
+	}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, decorator.CheckMessages(ctx, []sdk.Msg{send, belowSend, transfer}, memo))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	event, err := sdk.ParseTypedEvent(abci.Event(events[1]))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventTravelRule{
+		Sender:         alice,
+		Recipient:      bob,
+		Amount:         above[0],
+		Reference:      "tr-1",
+		OriginatorVasp: "vasp-a",
+	}, event)
+}
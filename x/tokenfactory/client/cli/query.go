@@ -22,6 +22,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListBlacklisted())
 	cmd.AddCommand(CmdShowBlacklisted())
+	cmd.AddCommand(CmdIsBlacklistedBatch())
 	cmd.AddCommand(CmdShowPaused())
 	cmd.AddCommand(CmdShowMasterMinter())
 	cmd.AddCommand(CmdListMinters())
@@ -31,7 +32,19 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdShowOwner())
 	cmd.AddCommand(CmdListMinterController())
 	cmd.AddCommand(CmdShowMinterController())
+	cmd.AddCommand(CmdMinterControllersByController())
+	cmd.AddCommand(CmdMinterControllersByMinter())
+	cmd.AddCommand(CmdListMintingDenom())
 	cmd.AddCommand(CmdShowMintingDenom())
+	cmd.AddCommand(CmdShowFactoryDenom())
+	cmd.AddCommand(CmdFactoryDenomsByCreator())
+	cmd.AddCommand(CmdShowAttestor())
+	cmd.AddCommand(CmdListAttestations())
+	cmd.AddCommand(CmdShowReserves())
+	cmd.AddCommand(CmdListPendingMints())
+	cmd.AddCommand(CmdShowPendingMint())
+	cmd.AddCommand(CmdListRedemptions())
+	cmd.AddCommand(CmdShowRedemption())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -21,10 +21,22 @@ func CmdListBlacklisted() *cobra.Command {
 				return err
 			}
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			sinceHeight, err := cmd.Flags().GetInt64(FlagSinceHeight)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			params := &types.QueryAllBlacklistedRequest{
-				Pagination: pageReq,
+				Denom:       denom,
+				Pagination:  pageReq,
+				SinceHeight: sinceHeight,
 			}
 
 			res, err := queryClient.BlacklistedAll(context.Background(), params)
@@ -37,6 +49,8 @@ func CmdListBlacklisted() *cobra.Command {
 	}
 
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	cmd.Flags().Int64(FlagSinceHeight, 0, "Only list the addresses blacklisted at or after this height")
+	addDenomFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -50,11 +64,17 @@ func CmdShowBlacklisted() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			argAddress := args[0]
 
 			params := &types.QueryGetBlacklistedRequest{
+				Denom:   denom,
 				Address: argAddress,
 			}
 
@@ -67,6 +87,42 @@ func CmdShowBlacklisted() *cobra.Command {
 		},
 	}
 
+	addDenomFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdIsBlacklistedBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "is-blacklisted-batch [address...]",
+		Short: "shows whether each of the addresses is blacklisted",
+		Args:  cobra.RangeArgs(1, types.MaxIsBlacklistedBatchSize),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryIsBlacklistedBatchRequest{
+				Denom:     denom,
+				Addresses: args,
+			}
+
+			res, err := queryClient.IsBlacklistedBatch(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addDenomFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -38,6 +38,7 @@ func networkWithBlacklistedObjects(t *testing.T, n int) (*network.Network, []typ
 		accounts[i] = account
 	}
 
+	setTestMintingDenom(t, &cfg, &state)
 	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
 	cfg.GenesisState[types.ModuleName] = buf
@@ -50,6 +51,7 @@ func TestShowBlacklisted(t *testing.T) {
 	ctx := net.Validators[0].ClientCtx
 	common := []string{
 		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+		fmt.Sprintf("--%s=%s", cli.FlagDenom, testDenom),
 	}
 	for _, tc := range []struct {
 		desc    string
@@ -93,6 +95,7 @@ func TestShowBlacklisted(t *testing.T) {
 					nullify.Fill(&tc.obj.AddressBz),
 					nullify.Fill(&resp.Blacklisted.AddressBz),
 				)
+				require.Equal(t, tc.obj.Address, resp.Address.Address)
 			}
 		})
 	}
@@ -105,6 +108,7 @@ func TestListBlacklisted(t *testing.T) {
 	request := func(next []byte, offset, limit uint64, total bool) []string {
 		args := []string{
 			fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			fmt.Sprintf("--%s=%s", cli.FlagDenom, testDenom),
 		}
 		if next == nil {
 			args = append(args, fmt.Sprintf("--%s=%d", flags.FlagOffset, offset))
@@ -163,3 +167,26 @@ func TestListBlacklisted(t *testing.T) {
 		)
 	})
 }
+
+func TestIsBlacklistedBatch(t *testing.T) {
+	net, _, objs := networkWithBlacklistedObjects(t, 2)
+
+	ctx := net.Validators[0].ClientCtx
+	other := sample.TestAccount().Address
+	args := []string{
+		objs[0].Address,
+		other,
+		objs[1].Address,
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+		fmt.Sprintf("--%s=%s", cli.FlagDenom, testDenom),
+	}
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdIsBlacklistedBatch(), args)
+	require.NoError(t, err)
+	var resp types.QueryIsBlacklistedBatchResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	require.Equal(t, []types.IsBlacklistedResult{
+		{Address: objs[0].Address, Blacklisted: true},
+		{Address: other, Blacklisted: false},
+		{Address: objs[1].Address, Blacklisted: true},
+	}, resp.Results)
+}
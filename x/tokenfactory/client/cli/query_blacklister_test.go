@@ -24,6 +24,7 @@ func networkWithBlacklisterObjects(t *testing.T) (*network.Network, types.Blackl
 	blacklister := &types.Blacklister{}
 	nullify.Fill(&blacklister)
 	state.Blacklister = blacklister
+	setTestMintingDenom(t, &cfg, &state)
 	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
 	cfg.GenesisState[types.ModuleName] = buf
@@ -36,6 +37,7 @@ func TestShowBlacklister(t *testing.T) {
 	ctx := net.Validators[0].ClientCtx
 	common := []string{
 		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+		fmt.Sprintf("--%s=%s", cli.FlagDenom, testDenom),
 	}
 	for _, tc := range []struct {
 		desc string
@@ -17,9 +17,16 @@ func CmdShowMasterMinter() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryGetMasterMinterRequest{}
+			params := &types.QueryGetMasterMinterRequest{
+				Denom: denom,
+			}
 
 			res, err := queryClient.MasterMinter(context.Background(), params)
 			if err != nil {
@@ -30,6 +37,7 @@ func CmdShowMasterMinter() *cobra.Command {
 		},
 	}
 
+	addDenomFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
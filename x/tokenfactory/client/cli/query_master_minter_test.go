@@ -24,6 +24,7 @@ func networkWithMasterMinterObjects(t *testing.T) (*network.Network, types.Maste
 	masterMinter := &types.MasterMinter{}
 	nullify.Fill(&masterMinter)
 	state.MasterMinter = masterMinter
+	setTestMintingDenom(t, &cfg, &state)
 	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
 	cfg.GenesisState[types.ModuleName] = buf
@@ -36,6 +37,7 @@ func TestShowMasterMinter(t *testing.T) {
 	ctx := net.Validators[0].ClientCtx
 	common := []string{
 		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+		fmt.Sprintf("--%s=%s", cli.FlagDenom, testDenom),
 	}
 	for _, tc := range []struct {
 		desc string
@@ -22,9 +22,15 @@ func CmdListMinterController() *cobra.Command {
 				return err
 			}
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			params := &types.QueryAllMinterControllerRequest{
+				Denom:      denom,
 				Pagination: pageReq,
 			}
 
@@ -38,6 +44,7 @@ func CmdListMinterController() *cobra.Command {
 	}
 
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	addDenomFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -45,18 +52,30 @@ func CmdListMinterController() *cobra.Command {
 
 func CmdShowMinterController() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "show-minter-controller [minter-address]",
+		Use:   "show-minter-controller [controller-address]",
 		Short: "shows a minter-controller",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			argControllerAddress := args[0]
 
+			minter, err := cmd.Flags().GetString(FlagMinter)
+			if err != nil {
+				return err
+			}
+
 			params := &types.QueryGetMinterControllerRequest{
+				Denom:             denom,
 				ControllerAddress: argControllerAddress,
+				MinterAddress:     minter,
 			}
 
 			res, err := queryClient.MinterController(context.Background(), params)
@@ -68,6 +87,92 @@ func CmdShowMinterController() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagMinter, "", "Minter of the controller, required if it controls several")
+	addDenomFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdMinterControllersByController() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "minter-controllers-by-controller [controller-address]",
+		Short: "list the minters of a minter-controller",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryMinterControllersByControllerRequest{
+				Denom:             denom,
+				ControllerAddress: args[0],
+				Pagination:        pageReq,
+			}
+
+			res, err := queryClient.MinterControllersByController(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	addDenomFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdMinterControllersByMinter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "minter-controllers-by-minter [minter-address]",
+		Short: "list the minter-controllers of a minter",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryMinterControllersByMinterRequest{
+				Denom:         denom,
+				MinterAddress: args[0],
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.MinterControllersByMinter(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	addDenomFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -34,6 +34,7 @@ func networkWithMinterControllerObjects(t *testing.T, n int) (*network.Network,
 		nullify.Fill(&minterController)
 		state.MinterControllerList = append(state.MinterControllerList, minterController)
 	}
+	setTestMintingDenom(t, &cfg, &state)
 	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
 	cfg.GenesisState[types.ModuleName] = buf
@@ -46,6 +47,7 @@ func TestShowMinterController(t *testing.T) {
 	ctx := net.Validators[0].ClientCtx
 	common := []string{
 		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+		fmt.Sprintf("--%s=%s", cli.FlagDenom, testDenom),
 	}
 	for _, tc := range []struct {
 		desc            string
@@ -101,6 +103,7 @@ func TestListMinterController(t *testing.T) {
 	request := func(next []byte, offset, limit uint64, total bool) []string {
 		args := []string{
 			fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			fmt.Sprintf("--%s=%s", cli.FlagDenom, testDenom),
 		}
 		if next == nil {
 			args = append(args, fmt.Sprintf("--%s=%d", flags.FlagOffset, offset))
@@ -21,9 +21,15 @@ func CmdListMinters() *cobra.Command {
 				return err
 			}
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			params := &types.QueryAllMintersRequest{
+				Denom:      denom,
 				Pagination: pageReq,
 			}
 
@@ -37,6 +43,7 @@ func CmdListMinters() *cobra.Command {
 	}
 
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	addDenomFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -50,11 +57,17 @@ func CmdShowMinters() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			argAddress := args[0]
 
 			params := &types.QueryGetMintersRequest{
+				Denom:   denom,
 				Address: argAddress,
 			}
 
@@ -67,6 +80,7 @@ func CmdShowMinters() *cobra.Command {
 		},
 	}
 
+	addDenomFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
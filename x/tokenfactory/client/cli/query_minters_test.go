@@ -34,6 +34,7 @@ func networkWithMintersObjects(t *testing.T, n int) (*network.Network, []types.M
 		nullify.Fill(&minters)
 		state.MintersList = append(state.MintersList, minters)
 	}
+	setTestMintingDenom(t, &cfg, &state)
 	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
 	cfg.GenesisState[types.ModuleName] = buf
@@ -46,6 +47,7 @@ func TestShowMinters(t *testing.T) {
 	ctx := net.Validators[0].ClientCtx
 	common := []string{
 		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+		fmt.Sprintf("--%s=%s", cli.FlagDenom, testDenom),
 	}
 	for _, tc := range []struct {
 		desc      string
@@ -101,6 +103,7 @@ func TestListMinters(t *testing.T) {
 	request := func(next []byte, offset, limit uint64, total bool) []string {
 		args := []string{
 			fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			fmt.Sprintf("--%s=%s", cli.FlagDenom, testDenom),
 		}
 		if next == nil {
 			args = append(args, fmt.Sprintf("--%s=%d", flags.FlagOffset, offset))
@@ -9,17 +9,52 @@ import (
 	"github.com/spf13/cobra"
 )
 
+func CmdListMintingDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-minting-denom",
+		Short: "list all minting-denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllMintingDenomRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.MintingDenomAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdShowMintingDenom() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "show-minting-denom",
-		Short: "shows minting-denom",
-		Args:  cobra.NoArgs,
+		Use:   "show-minting-denom [denom]",
+		Short: "shows a minting-denom",
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryGetMintingDenomRequest{}
+			params := &types.QueryGetMintingDenomRequest{
+				Denom: args[0],
+			}
 
 			res, err := queryClient.MintingDenom(context.Background(), params)
 			if err != nil {
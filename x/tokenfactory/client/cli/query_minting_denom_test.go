@@ -8,6 +8,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/noble-assets/noble/v5/testutil/network"
@@ -16,28 +17,35 @@ import (
 	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
 )
 
-func networkWithMintingDenomObjects(t *testing.T) (*network.Network, types.MintingDenom) {
-	t.Helper()
-	cfg := network.DefaultConfig()
-	state := types.GenesisState{}
-	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
-
-	testDenom := "test"
+// testDenom is the minting denom of the test networks.
+const testDenom = "test"
 
+// setTestMintingDenom sets testDenom as the minting denom of state, and
+// registers its metadata in the bank genesis of cfg.
+func setTestMintingDenom(t *testing.T, cfg *network.Config, state *types.GenesisState) {
+	t.Helper()
 	state.MintingDenom = &types.MintingDenom{
 		Denom: testDenom,
 	}
 
-	bankState := banktypes.DefaultGenesisState()
-	bankState.DenomMetadata = []banktypes.Metadata{{
+	bankState := banktypes.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[banktypes.ModuleName], &bankState))
+	bankState.DenomMetadata = append(bankState.DenomMetadata, banktypes.Metadata{
 		Base: testDenom,
-	}}
-
-	buf, err := cfg.Codec.MarshalJSON(bankState)
+	})
+	buf, err := cfg.Codec.MarshalJSON(&bankState)
 	require.NoError(t, err)
 	cfg.GenesisState[banktypes.ModuleName] = buf
+}
 
-	buf, err = cfg.Codec.MarshalJSON(&state)
+func networkWithMintingDenomObjects(t *testing.T) (*network.Network, types.MintingDenom) {
+	t.Helper()
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+
+	setTestMintingDenom(t, &cfg, &state)
+	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
 	cfg.GenesisState[types.ModuleName] = buf
 
@@ -52,19 +60,27 @@ func TestShowMintingDenom(t *testing.T) {
 		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 	}
 	for _, tc := range []struct {
-		desc string
-		args []string
-		err  error
-		obj  types.MintingDenom
+		desc  string
+		denom string
+		args  []string
+		err   error
+		obj   types.MintingDenom
 	}{
 		{
-			desc: "get",
-			args: common,
-			obj:  obj,
+			desc:  "get",
+			denom: testDenom,
+			args:  common,
+			obj:   obj,
+		},
+		{
+			desc:  "not found",
+			denom: "unknown",
+			args:  common,
+			err:   status.Error(codes.NotFound, "not found"),
 		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
-			var args []string
+			args := []string{tc.denom}
 			args = append(args, tc.args...)
 			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowMintingDenom(), args)
 			if tc.err != nil {
@@ -84,3 +100,17 @@ func TestShowMintingDenom(t *testing.T) {
 		})
 	}
 }
+
+func TestListMintingDenom(t *testing.T) {
+	net, obj := networkWithMintingDenomObjects(t)
+
+	ctx := net.Validators[0].ClientCtx
+	args := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListMintingDenom(), args)
+	require.NoError(t, err)
+	var resp types.QueryAllMintingDenomResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	require.Equal(t, []types.MintingDenom{obj}, resp.MintingDenom)
+}
@@ -17,9 +17,16 @@ func CmdShowPaused() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryGetPausedRequest{}
+			params := &types.QueryGetPausedRequest{
+				Denom: denom,
+			}
 
 			res, err := queryClient.Paused(context.Background(), params)
 			if err != nil {
@@ -30,6 +37,7 @@ func CmdShowPaused() *cobra.Command {
 		},
 	}
 
+	addDenomFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
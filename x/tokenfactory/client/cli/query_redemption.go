@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdListRedemptions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-redemptions",
+		Short: "list all redemptions, oldest first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			status, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+
+			redemptionStatus := types.REDEMPTION_STATUS_UNSPECIFIED
+			if status != "" {
+				value, ok := types.RedemptionStatus_value["REDEMPTION_STATUS_"+strings.ToUpper(status)]
+				if !ok {
+					return fmt.Errorf("invalid redemption status %s", status)
+				}
+				redemptionStatus = types.RedemptionStatus(value)
+			}
+
+			redeemer, err := cmd.Flags().GetString(FlagRedeemer)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRedemptionsRequest{
+				Denom:      denom,
+				Status:     redemptionStatus,
+				Redeemer:   redeemer,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Redemptions(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	cmd.Flags().String(FlagStatus, "", "Only list the redemptions with this status: pending, finalized or cancelled")
+	cmd.Flags().String(FlagRedeemer, "", "Only list the redemptions of this redeemer")
+	addDenomFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowRedemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-redemption [id]",
+		Short: "shows a redemption and its status",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			argID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetRedemptionRequest{
+				Denom: denom,
+				Id:    argID,
+			}
+
+			res, err := queryClient.Redemption(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addDenomFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
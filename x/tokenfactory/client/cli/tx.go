@@ -33,7 +33,36 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUnpause())
 	cmd.AddCommand(CmdConfigureMinterController())
 	cmd.AddCommand(CmdRemoveMinterController())
+	cmd.AddCommand(CmdIncreaseMinterAllowance())
+	cmd.AddCommand(CmdDecreaseMinterAllowance())
+	cmd.AddCommand(CmdCreateDenom())
+	cmd.AddCommand(CmdMintFactoryDenom())
+	cmd.AddCommand(CmdBurnFactoryDenom())
+	cmd.AddCommand(CmdChangeFactoryDenomAdmin())
+	cmd.AddCommand(CmdSetDenomMetadata())
+	cmd.AddCommand(CmdUpdateAttestor())
+	cmd.AddCommand(CmdSubmitAttestation())
+	cmd.AddCommand(CmdApproveMint())
+	cmd.AddCommand(CmdRejectMint())
+	cmd.AddCommand(CmdRequestRedemption())
+	cmd.AddCommand(CmdFinalizeRedemption())
+	cmd.AddCommand(CmdCancelRedemption())
+	cmd.AddCommand(CmdRegisterMintingDenom())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
 }
+
+const (
+	FlagDenom       = "denom"
+	FlagSinceHeight = "since-height"
+	FlagStatus      = "status"
+	FlagRedeemer    = "redeemer"
+)
+
+// addDenomFlag adds the required flag selecting the minting denom a command
+// applies to.
+func addDenomFlag(cmd *cobra.Command) {
+	cmd.Flags().String(FlagDenom, "", "Minting denom")
+	_ = cmd.MarkFlagRequired(FlagDenom)
+}
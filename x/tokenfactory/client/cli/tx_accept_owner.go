@@ -19,6 +19,11 @@ func CmdAcceptOwner() *cobra.Command {
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -26,12 +31,14 @@ func CmdAcceptOwner() *cobra.Command {
 
 			msg := types.NewMsgAcceptOwner(
 				clientCtx.GetFromAddress().String(),
+				denom,
 			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addDenomFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
 	"github.com/spf13/cobra"
 )
@@ -21,6 +22,21 @@ func CmdConfigureMinterController() *cobra.Command {
 			argController := args[0]
 			argMinter := args[1]
 
+			allowanceCap, err := parseAllowanceCap(cmd)
+			if err != nil {
+				return err
+			}
+
+			force, err := cmd.Flags().GetBool(FlagForce)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -28,15 +44,40 @@ func CmdConfigureMinterController() *cobra.Command {
 
 			msg := types.NewMsgConfigureMinterController(
 				clientCtx.GetFromAddress().String(),
+				denom,
 				argController,
 				argMinter,
+				allowanceCap,
+				force,
 			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagAllowanceCap, "", "Cap on the cumulative allowance the controller may grant the minter")
+	cmd.Flags().Bool(FlagForce, false, "Overwrite the existing configuration of the controller and minter pair")
+	addDenomFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+const (
+	FlagAllowanceCap = "allowance-cap"
+	FlagForce        = "force"
+)
+
+// parseAllowanceCap returns the coin of the allowance cap flag, or nil if it
+// is not set.
+func parseAllowanceCap(cmd *cobra.Command) (*sdk.Coin, error) {
+	value, err := cmd.Flags().GetString(FlagAllowanceCap)
+	if err != nil || value == "" {
+		return nil, err
+	}
+	allowanceCap, err := sdk.ParseCoinNormalized(value)
+	if err != nil {
+		return nil, err
+	}
+	return &allowanceCap, nil
+}
@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdDecreaseMinterAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decrease-minter-allowance [address] [amount]",
+		Short: "Broadcast message decrease-minter-allowance",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argAddress := args[0]
+			argAmount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			expectedAllowance, err := parseExpectedAllowance(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDecreaseMinterAllowance(
+				clientCtx.GetFromAddress().String(),
+				argAddress,
+				argAmount,
+				expectedAllowance,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExpectedAllowance, "", "Fail unless the current allowance of the minter is this one")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -19,6 +19,11 @@ func CmdPause() *cobra.Command {
 		Args:  cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -26,12 +31,14 @@ func CmdPause() *cobra.Command {
 
 			msg := types.NewMsgPause(
 				clientCtx.GetFromAddress().String(),
+				denom,
 			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addDenomFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
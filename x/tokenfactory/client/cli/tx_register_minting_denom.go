@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdRegisterMintingDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-minting-denom [denom] [owner]",
+		Short: "Broadcast message register-minting-denom, registering a denom with bank metadata as a new minting denom",
+		Long: `Broadcast message register-minting-denom, registering a denom with bank metadata as a new minting denom.
+The denom starts unpaused with the given owner, and must be registered by the authority of the chain.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDenom := args[0]
+			argOwner := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterMintingDenom(
+				clientCtx.GetFromAddress().String(),
+				argDenom,
+				argOwner,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -20,6 +20,11 @@ func CmdRemoveMinter() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argAddress := args[0]
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -27,6 +32,7 @@ func CmdRemoveMinter() *cobra.Command {
 
 			msg := types.NewMsgRemoveMinter(
 				clientCtx.GetFromAddress().String(),
+				denom,
 				argAddress,
 			)
 
@@ -34,6 +40,7 @@ func CmdRemoveMinter() *cobra.Command {
 		},
 	}
 
+	addDenomFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -17,10 +17,21 @@ func CmdRemoveMinterController() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "remove-minter-controller [controller]",
 		Short: "Broadcast message remove-minter-controller",
+		Long:  "Remove the minter controller, for all of its minters unless --minter is set",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argAddress := args[0]
 
+			minter, err := cmd.Flags().GetString(FlagMinter)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -28,14 +39,20 @@ func CmdRemoveMinterController() *cobra.Command {
 
 			msg := types.NewMsgRemoveMinterController(
 				clientCtx.GetFromAddress().String(),
+				denom,
 				argAddress,
+				minter,
 			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagMinter, "", "Only remove the controller of this minter, instead of all its minters")
+	addDenomFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+const FlagMinter = "minter"
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSetDenomMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [metadata-file]",
+		Short: "Broadcast message set-denom-metadata, setting the bank metadata of the minting denom that is its base",
+		Long: `Broadcast message set-denom-metadata, setting the bank metadata of the minting denom that is its base.
+The metadata is read from a JSON file, e.g.:
+
+{
+  "description": "Test Token",
+  "denom_units": [
+    {"denom": "utoken", "exponent": 0, "aliases": ["microtoken"]},
+    {"denom": "token", "exponent": 6}
+  ],
+  "base": "utoken",
+  "display": "token",
+  "name": "Test Token",
+  "symbol": "TOKEN",
+  "uri": "",
+  "uri_hash": ""
+}`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var argMetadata banktypes.Metadata
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &argMetadata); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDenomMetadata(
+				clientCtx.GetFromAddress().String(),
+				argMetadata,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSubmitAttestation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-attestation [reserves] [timestamp] [uri] [hash]",
+		Short: "Broadcast message submit-attestation, with an RFC 3339 timestamp and a hex encoded report hash",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argReserves, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			argTimestamp, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return err
+			}
+
+			argUri := args[2]
+
+			argHash, err := hex.DecodeString(args[3])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitAttestation(
+				clientCtx.GetFromAddress().String(),
+				argReserves,
+				argTimestamp,
+				argUri,
+				argHash,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
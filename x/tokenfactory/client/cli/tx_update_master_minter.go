@@ -20,6 +20,11 @@ func CmdUpdateMasterMinter() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argAddress := args[0]
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -27,6 +32,7 @@ func CmdUpdateMasterMinter() *cobra.Command {
 
 			msg := types.NewMsgUpdateMasterMinter(
 				clientCtx.GetFromAddress().String(),
+				denom,
 				argAddress,
 			)
 
@@ -34,6 +40,7 @@ func CmdUpdateMasterMinter() *cobra.Command {
 		},
 	}
 
+	addDenomFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
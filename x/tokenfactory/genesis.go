@@ -10,47 +10,82 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k *keeper.Keeper, bankKeeper types.BankKeeper, genState types.GenesisState) {
-	for _, elem := range genState.BlacklistedList {
-		k.SetBlacklisted(ctx, elem)
+	for _, denomState := range genState.DenomStates() {
+		initDenomGenesis(ctx, k, bankKeeper, denomState)
 	}
+	for _, elem := range genState.FactoryDenoms {
+		k.SetFactoryDenom(ctx, elem)
+	}
+	// this line is used by starport scaffolding # genesis/module/init
+	k.SetParams(ctx, genState.Params)
+}
+
+// initDenomGenesis initializes the state of a single minting denom.
+func initDenomGenesis(ctx sdk.Context, k *keeper.Keeper, bankKeeper types.BankKeeper, denomState types.DenomGenesisState) {
+	denom := denomState.MintingDenom.Denom
+
+	_, found := bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		panic(sdkerrors.Wrapf(types.ErrDenomNotRegistered, "tokenfactory minting denom %s is not registered in bank module denom_metadata", denom))
+	}
+	k.SetMintingDenom(ctx, denomState.MintingDenom)
 
-	if genState.Paused != nil {
-		k.SetPaused(ctx, *genState.Paused)
+	for _, elem := range denomState.BlacklistedList {
+		k.SetBlacklisted(ctx, denom, elem)
 	}
 
-	if genState.MasterMinter != nil {
-		k.SetMasterMinter(ctx, *genState.MasterMinter)
+	if denomState.Paused != nil {
+		k.SetPaused(ctx, denom, *denomState.Paused)
+	} else {
+		k.SetPaused(ctx, denom, types.Paused{Paused: false})
 	}
 
-	for _, elem := range genState.MintersList {
-		k.SetMinters(ctx, elem)
+	if denomState.MasterMinter != nil {
+		k.SetMasterMinter(ctx, denom, *denomState.MasterMinter)
 	}
 
-	if genState.Pauser != nil {
-		k.SetPauser(ctx, *genState.Pauser)
+	for _, elem := range denomState.MintersList {
+		k.SetMinters(ctx, denom, elem)
 	}
 
-	if genState.Blacklister != nil {
-		k.SetBlacklister(ctx, *genState.Blacklister)
+	if denomState.Pauser != nil {
+		k.SetPauser(ctx, denom, *denomState.Pauser)
 	}
 
-	if genState.Owner != nil {
-		k.SetOwner(ctx, *genState.Owner)
+	if denomState.Blacklister != nil {
+		k.SetBlacklister(ctx, denom, *denomState.Blacklister)
 	}
 
-	for _, elem := range genState.MinterControllerList {
-		k.SetMinterController(ctx, elem)
+	if denomState.Owner != nil {
+		k.SetOwner(ctx, denom, *denomState.Owner)
 	}
 
-	if genState.MintingDenom != nil {
-		_, found := bankKeeper.GetDenomMetaData(ctx, genState.MintingDenom.Denom)
-		if !found {
-			panic(sdkerrors.Wrapf(types.ErrDenomNotRegistered, "tokenfactory minting denom %s is not registered in bank module denom_metadata", genState.MintingDenom.Denom))
+	for _, elem := range denomState.MinterControllerList {
+		k.SetMinterController(ctx, denom, elem)
+	}
+
+	if denomState.Attestor != nil {
+		k.SetAttestor(ctx, denom, *denomState.Attestor)
+	}
+
+	var attestationCount uint64
+	for _, elem := range denomState.Attestations {
+		k.SetAttestation(ctx, denom, elem)
+		if elem.Id >= attestationCount {
+			attestationCount = elem.Id + 1
 		}
-		k.SetMintingDenom(ctx, *genState.MintingDenom)
 	}
-	// this line is used by starport scaffolding # genesis/module/init
-	k.SetParams(ctx, genState.Params)
+	k.SetAttestationCount(ctx, denom, attestationCount)
+
+	for _, elem := range denomState.PendingMints {
+		k.SetPendingMint(ctx, denom, elem)
+	}
+	k.SetPendingMintCount(ctx, denom, denomState.PendingMintCount)
+
+	for _, elem := range denomState.Redemptions {
+		k.SetRedemption(ctx, denom, elem)
+	}
+	k.SetRedemptionCount(ctx, denom, denomState.RedemptionCount)
 }
 
 // ExportGenesis returns the module's exported GenesisState
@@ -58,36 +93,56 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 
-	genesis.BlacklistedList = k.GetAllBlacklisted(ctx)
+	for _, mintingDenom := range k.GetAllMintingDenoms(ctx) {
+		genesis.Denoms = append(genesis.Denoms, exportDenomGenesis(ctx, k, mintingDenom))
+	}
+	genesis.FactoryDenoms = k.GetAllFactoryDenoms(ctx)
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
+
+// exportDenomGenesis returns the state of a single minting denom.
+func exportDenomGenesis(ctx sdk.Context, k *keeper.Keeper, mintingDenom types.MintingDenom) types.DenomGenesisState {
+	denom := mintingDenom.Denom
+	denomState := types.DenomGenesisState{MintingDenom: mintingDenom}
 
-	paused := k.GetPaused(ctx)
-	genesis.Paused = &paused
+	denomState.BlacklistedList = k.GetAllBlacklisted(ctx, denom)
 
-	masterMinter, found := k.GetMasterMinter(ctx)
+	paused := k.GetPaused(ctx, denom)
+	denomState.Paused = &paused
+
+	masterMinter, found := k.GetMasterMinter(ctx, denom)
 	if found {
-		genesis.MasterMinter = &masterMinter
+		denomState.MasterMinter = &masterMinter
 	}
-	genesis.MintersList = k.GetAllMinters(ctx)
+	denomState.MintersList = k.GetAllMinters(ctx, denom)
 
-	pauser, found := k.GetPauser(ctx)
+	pauser, found := k.GetPauser(ctx, denom)
 	if found {
-		genesis.Pauser = &pauser
+		denomState.Pauser = &pauser
 	}
 
-	blacklister, found := k.GetBlacklister(ctx)
+	blacklister, found := k.GetBlacklister(ctx, denom)
 	if found {
-		genesis.Blacklister = &blacklister
+		denomState.Blacklister = &blacklister
 	}
 
-	owner, found := k.GetOwner(ctx)
+	owner, found := k.GetOwner(ctx, denom)
 	if found {
-		genesis.Owner = &owner
+		denomState.Owner = &owner
 	}
-	genesis.MinterControllerList = k.GetAllMinterControllers(ctx)
+	denomState.MinterControllerList = k.GetAllMinterControllers(ctx, denom)
 
-	mintingDenom := k.GetMintingDenom(ctx)
-	genesis.MintingDenom = &mintingDenom
-	// this line is used by starport scaffolding # genesis/module/export
+	attestor, found := k.GetAttestor(ctx, denom)
+	if found {
+		denomState.Attestor = &attestor
+	}
+	denomState.Attestations = k.GetAllAttestations(ctx, denom)
+	denomState.PendingMints = k.GetAllPendingMints(ctx, denom)
+	denomState.PendingMintCount = k.GetPendingMintCount(ctx, denom)
+	denomState.Redemptions = k.GetAllRedemptions(ctx, denom)
+	denomState.RedemptionCount = k.GetRedemptionCount(ctx, denom)
 
-	return genesis
+	return denomState
 }
@@ -2,7 +2,9 @@ package tokenfactory_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
 	"github.com/noble-assets/noble/v5/testutil/nullify"
 	"github.com/noble-assets/noble/v5/x/tokenfactory"
@@ -57,6 +59,58 @@ func TestGenesis(t *testing.T) {
 		MintingDenom: &types.MintingDenom{
 			Denom: "65",
 		},
+		Denoms: []types.DenomGenesisState{
+			{
+				MintingDenom: types.MintingDenom{
+					Denom: "66",
+				},
+				BlacklistedList: []types.Blacklisted{
+					{
+						AddressBz: []byte("2"),
+					},
+				},
+				Paused: &types.Paused{
+					Paused: false,
+				},
+				Owner: &types.Owner{
+					Address: "99",
+				},
+				Attestor: &types.Attestor{
+					Address: "97",
+				},
+				Attestations: []types.Attestation{
+					{
+						Id:        0,
+						Attestor:  "97",
+						Reserves:  sdk.Coin{Denom: "66", Amount: sdk.NewInt(100)},
+						Timestamp: time.Unix(1_700_000_000, 0).UTC(),
+						Uri:       "https://example.com/report",
+						Hash:      []byte{0x01},
+					},
+				},
+				PendingMints: []types.PendingMint{
+					{
+						Id:        1,
+						Minter:    "0",
+						Address:   "1",
+						Amount:    sdk.Coin{Denom: "66", Amount: sdk.NewInt(100)},
+						ExpiresAt: time.Unix(1_700_000_000, 0).UTC(),
+					},
+				},
+				PendingMintCount: 2,
+				Redemptions: []types.Redemption{
+					{
+						Id:            0,
+						Redeemer:      "2",
+						Amount:        sdk.Coin{Denom: "66", Amount: sdk.NewInt(10)},
+						BankReference: "wire 42",
+						Status:        types.REDEMPTION_STATUS_PENDING,
+						Height:        5,
+					},
+				},
+				RedemptionCount: 1,
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -65,17 +119,24 @@ func TestGenesis(t *testing.T) {
 	got := tokenfactory.ExportGenesis(ctx, k)
 	require.NotNil(t, got)
 
-	nullify.Fill(&genesisState)
-	nullify.Fill(got)
-
-	require.ElementsMatch(t, genesisState.BlacklistedList, got.BlacklistedList)
-	require.Equal(t, genesisState.Paused, got.Paused)
-	require.Equal(t, genesisState.MasterMinter, got.MasterMinter)
-	require.ElementsMatch(t, genesisState.MintersList, got.MintersList)
-	require.Equal(t, genesisState.Pauser, got.Pauser)
-	require.Equal(t, genesisState.Blacklister, got.Blacklister)
-	require.Equal(t, genesisState.Owner, got.Owner)
-	require.ElementsMatch(t, genesisState.MinterControllerList, got.MinterControllerList)
-	require.Equal(t, genesisState.MintingDenom, got.MintingDenom)
+	expected := genesisState.DenomStates()
+	require.Len(t, got.Denoms, len(expected))
+	for i, denomState := range got.Denoms {
+		require.Equal(t, expected[i].MintingDenom, denomState.MintingDenom)
+		require.ElementsMatch(t, expected[i].BlacklistedList, denomState.BlacklistedList)
+		require.Equal(t, expected[i].Paused, denomState.Paused)
+		require.Equal(t, expected[i].MasterMinter, denomState.MasterMinter)
+		require.ElementsMatch(t, nullify.Fill(expected[i].MintersList), nullify.Fill(denomState.MintersList))
+		require.Equal(t, expected[i].Pauser, denomState.Pauser)
+		require.Equal(t, expected[i].Blacklister, denomState.Blacklister)
+		require.Equal(t, expected[i].Owner, denomState.Owner)
+		require.ElementsMatch(t, expected[i].MinterControllerList, denomState.MinterControllerList)
+		require.Equal(t, expected[i].Attestor, denomState.Attestor)
+		require.Equal(t, expected[i].Attestations, denomState.Attestations)
+		require.Equal(t, expected[i].PendingMints, denomState.PendingMints)
+		require.Equal(t, expected[i].PendingMintCount, denomState.PendingMintCount)
+		require.Equal(t, expected[i].Redemptions, denomState.Redemptions)
+		require.Equal(t, expected[i].RedemptionCount, denomState.RedemptionCount)
+	}
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetAttestationCount returns the number of attestations ever submitted for
+// a minting denom, which is the id of the next one.
+func (k Keeper) GetAttestationCount(ctx sdk.Context, denom string) uint64 {
+	store := k.denomStore(ctx, denom)
+
+	b := store.Get(types.KeyPrefix(types.AttestationCountKey))
+	if b == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(b)
+}
+
+// SetAttestationCount sets the number of attestations of a minting denom
+func (k Keeper) SetAttestationCount(ctx sdk.Context, denom string, count uint64) {
+	store := k.denomStore(ctx, denom)
+	store.Set(types.KeyPrefix(types.AttestationCountKey), sdk.Uint64ToBigEndian(count))
+}
+
+// AppendAttestation stores attestation under the next id and returns the id
+func (k Keeper) AppendAttestation(ctx sdk.Context, denom string, attestation types.Attestation) uint64 {
+	count := k.GetAttestationCount(ctx, denom)
+
+	attestation.Id = count
+	k.SetAttestation(ctx, denom, attestation)
+	k.SetAttestationCount(ctx, denom, count+1)
+
+	return count
+}
+
+// SetAttestation set a specific attestation in the store from its id
+func (k Keeper) SetAttestation(ctx sdk.Context, denom string, attestation types.Attestation) {
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.AttestationKeyPrefix))
+	b := k.cdc.MustMarshal(&attestation)
+	store.Set(types.AttestationKey(attestation.Id), b)
+}
+
+// GetAttestation returns an attestation from its id
+func (k Keeper) GetAttestation(ctx sdk.Context, denom string, id uint64) (val types.Attestation, found bool) {
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.AttestationKeyPrefix))
+
+	b := store.Get(types.AttestationKey(id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetLatestAttestation returns the last attestation submitted for a minting
+// denom
+func (k Keeper) GetLatestAttestation(ctx sdk.Context, denom string) (val types.Attestation, found bool) {
+	count := k.GetAttestationCount(ctx, denom)
+	if count == 0 {
+		return val, false
+	}
+
+	return k.GetAttestation(ctx, denom, count-1)
+}
+
+// GetAllAttestations returns all attestations of a minting denom, oldest
+// first
+func (k Keeper) GetAllAttestations(ctx sdk.Context, denom string) (list []types.Attestation) {
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.AttestationKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Attestation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
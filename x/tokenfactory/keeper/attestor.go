@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetAttestor set attestor in the store
+func (k Keeper) SetAttestor(ctx sdk.Context, denom string, attestor types.Attestor) {
+	store := k.denomStore(ctx, denom)
+	b := k.cdc.MustMarshal(&attestor)
+	store.Set(types.KeyPrefix(types.AttestorKey), b)
+}
+
+// GetAttestor returns attestor
+func (k Keeper) GetAttestor(ctx sdk.Context, denom string) (val types.Attestor, found bool) {
+	store := k.denomStore(ctx, denom)
+
+	b := store.Get(types.KeyPrefix(types.AttestorKey))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
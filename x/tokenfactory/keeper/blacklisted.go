@@ -7,15 +7,15 @@ import (
 )
 
 // SetBlacklisted set a specific blacklisted in the store from its index
-func (k Keeper) SetBlacklisted(ctx sdk.Context, blacklisted types.Blacklisted) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlacklistedKeyPrefix))
+func (k Keeper) SetBlacklisted(ctx sdk.Context, denom string, blacklisted types.Blacklisted) {
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.BlacklistedKeyPrefix))
 	b := k.cdc.MustMarshal(&blacklisted)
 	store.Set(types.BlacklistedKey(blacklisted.AddressBz), b)
 }
 
 // GetBlacklisted returns a blacklisted from its index
-func (k Keeper) GetBlacklisted(ctx sdk.Context, addressBz []byte) (val types.Blacklisted, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlacklistedKeyPrefix))
+func (k Keeper) GetBlacklisted(ctx sdk.Context, denom string, addressBz []byte) (val types.Blacklisted, found bool) {
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.BlacklistedKeyPrefix))
 
 	b := store.Get(types.BlacklistedKey(addressBz))
 	if b == nil {
@@ -27,14 +27,14 @@ func (k Keeper) GetBlacklisted(ctx sdk.Context, addressBz []byte) (val types.Bla
 }
 
 // RemoveBlacklisted removes a blacklisted from the store
-func (k Keeper) RemoveBlacklisted(ctx sdk.Context, addressBz []byte) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlacklistedKeyPrefix))
+func (k Keeper) RemoveBlacklisted(ctx sdk.Context, denom string, addressBz []byte) {
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.BlacklistedKeyPrefix))
 	store.Delete(types.BlacklistedKey(addressBz))
 }
 
 // GetAllBlacklisted returns all blacklisted
-func (k Keeper) GetAllBlacklisted(ctx sdk.Context) (list []types.Blacklisted) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlacklistedKeyPrefix))
+func (k Keeper) GetAllBlacklisted(ctx sdk.Context, denom string) (list []types.Blacklisted) {
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.BlacklistedKeyPrefix))
 	iterator := sdk.KVStorePrefixIterator(store, []byte{})
 
 	defer iterator.Close()
@@ -28,7 +28,7 @@ func createNBlacklisted(keeper *keeper.Keeper, ctx sdk.Context, n int) []blackli
 		items[i].address = acc.Address
 		items[i].bl.AddressBz = acc.AddressBz
 
-		keeper.SetBlacklisted(ctx, items[i].bl)
+		keeper.SetBlacklisted(ctx, testDenom, items[i].bl)
 	}
 	return items
 }
@@ -37,7 +37,7 @@ func TestBlacklistedGet(t *testing.T) {
 	keeper, ctx := keepertest.TokenfactoryKeeper(t)
 	items := createNBlacklisted(keeper, ctx, 10)
 	for _, item := range items {
-		rst, found := keeper.GetBlacklisted(ctx,
+		rst, found := keeper.GetBlacklisted(ctx, testDenom,
 			item.bl.AddressBz,
 		)
 		require.True(t, found)
@@ -52,10 +52,10 @@ func TestBlacklistedRemove(t *testing.T) {
 	keeper, ctx := keepertest.TokenfactoryKeeper(t)
 	items := createNBlacklisted(keeper, ctx, 10)
 	for _, item := range items {
-		keeper.RemoveBlacklisted(ctx,
+		keeper.RemoveBlacklisted(ctx, testDenom,
 			item.bl.AddressBz,
 		)
-		_, found := keeper.GetBlacklisted(ctx,
+		_, found := keeper.GetBlacklisted(ctx, testDenom,
 			item.bl.AddressBz,
 		)
 		require.False(t, found)
@@ -71,6 +71,6 @@ func TestBlacklistedGetAll(t *testing.T) {
 	}
 	require.ElementsMatch(t,
 		nullify.Fill(blacklisted),
-		nullify.Fill(keeper.GetAllBlacklisted(ctx)),
+		nullify.Fill(keeper.GetAllBlacklisted(ctx, testDenom)),
 	)
 }
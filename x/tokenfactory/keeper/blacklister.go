@@ -7,15 +7,15 @@ import (
 )
 
 // SetBlacklister set blacklister in the store
-func (k Keeper) SetBlacklister(ctx sdk.Context, blacklister types.Blacklister) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) SetBlacklister(ctx sdk.Context, denom string, blacklister types.Blacklister) {
+	store := k.denomStore(ctx, denom)
 	b := k.cdc.MustMarshal(&blacklister)
 	store.Set(types.KeyPrefix(types.BlacklisterKey), b)
 }
 
 // GetBlacklister returns blacklister
-func (k Keeper) GetBlacklister(ctx sdk.Context) (val types.Blacklister, found bool) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) GetBlacklister(ctx sdk.Context, denom string) (val types.Blacklister, found bool) {
+	store := k.denomStore(ctx, denom)
 
 	b := store.Get(types.KeyPrefix(types.BlacklisterKey))
 	if b == nil {
@@ -14,14 +14,14 @@ import (
 
 func createTestBlacklister(keeper *keeper.Keeper, ctx sdk.Context) types.Blacklister {
 	item := types.Blacklister{}
-	keeper.SetBlacklister(ctx, item)
+	keeper.SetBlacklister(ctx, testDenom, item)
 	return item
 }
 
 func TestBlacklisterGet(t *testing.T) {
 	keeper, ctx := keepertest.TokenfactoryKeeper(t)
 	item := createTestBlacklister(keeper, ctx)
-	rst, found := keeper.GetBlacklister(ctx)
+	rst, found := keeper.GetBlacklister(ctx, testDenom)
 	require.True(t, found)
 	require.Equal(t,
 		nullify.Fill(&item),
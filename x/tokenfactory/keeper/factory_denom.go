@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetFactoryDenom set a specific factoryDenom in the store from its index
+func (k Keeper) SetFactoryDenom(ctx sdk.Context, factoryDenom types.FactoryDenom) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FactoryDenomKeyPrefix))
+	b := k.cdc.MustMarshal(&factoryDenom)
+	store.Set(types.FactoryDenomKey(factoryDenom.Denom), b)
+}
+
+// GetFactoryDenom returns a factoryDenom from its index
+func (k Keeper) GetFactoryDenom(ctx sdk.Context, denom string) (val types.FactoryDenom, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FactoryDenomKeyPrefix))
+
+	b := store.Get(types.FactoryDenomKey(denom))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllFactoryDenoms returns all factoryDenom
+func (k Keeper) GetAllFactoryDenoms(ctx sdk.Context) (list []types.FactoryDenom) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FactoryDenomKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FactoryDenom
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Attestor(c context.Context, req *types.QueryGetAttestorRequest) (*types.QueryGetAttestorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetAttestor(ctx, req.Denom)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetAttestorResponse{Attestor: val}, nil
+}
+
+func (k Keeper) Attestations(c context.Context, req *types.QueryAttestationsRequest) (*types.QueryAttestationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var attestations []types.Attestation
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(k.denomStore(ctx, req.Denom), types.KeyPrefix(types.AttestationKeyPrefix))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var attestation types.Attestation
+		if err := k.cdc.Unmarshal(value, &attestation); err != nil {
+			return err
+		}
+
+		attestations = append(attestations, attestation)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAttestationsResponse{Attestations: attestations, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Reserves(c context.Context, req *types.QueryReservesRequest) (*types.QueryReservesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasMintingDenom(ctx, req.Denom) {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	res := &types.QueryReservesResponse{Supply: k.bankKeeper.GetSupply(ctx, req.Denom)}
+
+	latest, found := k.GetLatestAttestation(ctx, req.Denom)
+	if found {
+		res.Attestation = &latest
+		res.Backed = !latest.Reserves.IsLT(res.Supply)
+	}
+
+	return res, nil
+}
@@ -16,28 +16,39 @@ func (k Keeper) BlacklistedAll(c context.Context, req *types.QueryAllBlacklisted
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
+	if req.SinceHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "since height cannot be negative")
+	}
 
 	var blacklisteds []types.Blacklisted
+	var addresses []types.BlacklistedAddress
 	ctx := sdk.UnwrapSDKContext(c)
 
-	store := ctx.KVStore(k.storeKey)
+	store := k.denomStore(ctx, req.Denom)
 	blacklistedStore := prefix.NewStore(store, types.KeyPrefix(types.BlacklistedKeyPrefix))
 
-	pageRes, err := query.Paginate(blacklistedStore, req.Pagination, func(key []byte, value []byte) error {
+	pageRes, err := query.FilteredPaginate(blacklistedStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var blacklisted types.Blacklisted
 		if err := k.cdc.Unmarshal(value, &blacklisted); err != nil {
-			return err
+			return false, err
+		}
+
+		if blacklisted.Height < req.SinceHeight {
+			return false, nil
 		}
 
-		blacklisteds = append(blacklisteds, blacklisted)
-		return nil
+		if accumulate {
+			blacklisteds = append(blacklisteds, blacklisted)
+			addresses = append(addresses, types.NewBlacklistedAddress(blacklisted))
+		}
+		return true, nil
 	})
 
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryAllBlacklistedResponse{Blacklisted: blacklisteds, Pagination: pageRes}, nil
+	return &types.QueryAllBlacklistedResponse{Blacklisted: blacklisteds, Pagination: pageRes, Addresses: addresses}, nil
 }
 
 func (k Keeper) Blacklisted(c context.Context, req *types.QueryGetBlacklistedRequest) (*types.QueryGetBlacklistedResponse, error) {
@@ -51,10 +62,33 @@ func (k Keeper) Blacklisted(c context.Context, req *types.QueryGetBlacklistedReq
 		return nil, err
 	}
 
-	val, found := k.GetBlacklisted(ctx, addressBz)
+	val, found := k.GetBlacklisted(ctx, req.Denom, addressBz)
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
 
-	return &types.QueryGetBlacklistedResponse{Blacklisted: val}, nil
+	return &types.QueryGetBlacklistedResponse{Blacklisted: val, Address: types.NewBlacklistedAddress(val)}, nil
+}
+
+func (k Keeper) IsBlacklistedBatch(c context.Context, req *types.QueryIsBlacklistedBatchRequest) (*types.QueryIsBlacklistedBatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.Addresses) > types.MaxIsBlacklistedBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot check more than %d addresses at once", types.MaxIsBlacklistedBatchSize)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	results := make([]types.IsBlacklistedResult, 0, len(req.Addresses))
+	for _, address := range req.Addresses {
+		_, addressBz, err := bech32.DecodeAndConvert(address)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %s", address, err)
+		}
+
+		_, found := k.GetBlacklisted(ctx, req.Denom, addressBz)
+		results = append(results, types.IsBlacklistedResult{Address: address, Blacklisted: found})
+	}
+
+	return &types.QueryIsBlacklistedBatchResponse{Results: results}, nil
 }
@@ -32,20 +32,29 @@ func TestBlacklistedQuerySingle(t *testing.T) {
 		{
 			desc: "First",
 			request: &types.QueryGetBlacklistedRequest{
+				Denom:   testDenom,
 				Address: msgs[0].address,
 			},
-			response: &types.QueryGetBlacklistedResponse{Blacklisted: msgs[0].bl},
+			response: &types.QueryGetBlacklistedResponse{
+				Blacklisted: msgs[0].bl,
+				Address:     types.NewBlacklistedAddress(msgs[0].bl),
+			},
 		},
 		{
 			desc: "Second",
 			request: &types.QueryGetBlacklistedRequest{
+				Denom:   testDenom,
 				Address: msgs[1].address,
 			},
-			response: &types.QueryGetBlacklistedResponse{Blacklisted: msgs[1].bl},
+			response: &types.QueryGetBlacklistedResponse{
+				Blacklisted: msgs[1].bl,
+				Address:     types.NewBlacklistedAddress(msgs[1].bl),
+			},
 		},
 		{
 			desc: "KeyNotFound",
 			request: &types.QueryGetBlacklistedRequest{
+				Denom:   testDenom,
 				Address: sample.AccAddress(),
 			},
 			err: status.Error(codes.NotFound, "not found"),
@@ -81,6 +90,7 @@ func TestBlacklistedQueryPaginated(t *testing.T) {
 
 	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllBlacklistedRequest {
 		return &types.QueryAllBlacklistedRequest{
+			Denom: testDenom,
 			Pagination: &query.PageRequest{
 				Key:        next,
 				Offset:     offset,
@@ -124,8 +134,90 @@ func TestBlacklistedQueryPaginated(t *testing.T) {
 			nullify.Fill(resp.Blacklisted),
 		)
 	})
+	t.Run("Reverse", func(t *testing.T) {
+		forward, err := keeper.BlacklistedAll(wctx, request(nil, 0, 0, false))
+		require.NoError(t, err)
+		req := request(nil, 0, 0, false)
+		req.Pagination.Reverse = true
+		reverse, err := keeper.BlacklistedAll(wctx, req)
+		require.NoError(t, err)
+		require.Len(t, reverse.Blacklisted, len(forward.Blacklisted))
+		for i := range forward.Blacklisted {
+			require.Equal(t, forward.Blacklisted[i], reverse.Blacklisted[len(reverse.Blacklisted)-1-i])
+		}
+	})
+	t.Run("Addresses", func(t *testing.T) {
+		resp, err := keeper.BlacklistedAll(wctx, request(nil, 0, 0, false))
+		require.NoError(t, err)
+		require.Len(t, resp.Addresses, len(msgs))
+		for i, address := range resp.Addresses {
+			require.Equal(t, sdk.AccAddress(resp.Blacklisted[i].AddressBz).String(), address.Address)
+			require.Equal(t, resp.Blacklisted[i].AddressBz, address.AddressBz)
+		}
+	})
 	t.Run("InvalidRequest", func(t *testing.T) {
 		_, err := keeper.BlacklistedAll(wctx, nil)
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestBlacklistedQuerySinceHeight(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNBlacklisted(keeper, ctx, 5)
+	for i := range msgs {
+		msgs[i].bl.Height = int64(i + 1)
+		keeper.SetBlacklisted(ctx, testDenom, msgs[i].bl)
+	}
+
+	request := func(sinceHeight int64, limit uint64) *types.QueryAllBlacklistedRequest {
+		return &types.QueryAllBlacklistedRequest{
+			Denom:       testDenom,
+			SinceHeight: sinceHeight,
+			Pagination:  &query.PageRequest{Limit: limit, CountTotal: true},
+		}
+	}
+
+	resp, err := keeper.BlacklistedAll(wctx, request(3, 0))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), resp.Pagination.Total)
+	require.ElementsMatch(t, []types.Blacklisted{msgs[2].bl, msgs[3].bl, msgs[4].bl}, resp.Blacklisted)
+
+	// the filter applies before the limit
+	resp, err = keeper.BlacklistedAll(wctx, request(5, 1))
+	require.NoError(t, err)
+	require.Equal(t, []types.Blacklisted{msgs[4].bl}, resp.Blacklisted)
+
+	_, err = keeper.BlacklistedAll(wctx, request(-1, 0))
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "since height cannot be negative"))
+}
+
+func TestIsBlacklistedBatchQuery(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNBlacklisted(keeper, ctx, 2)
+	other := sample.AccAddress()
+
+	resp, err := keeper.IsBlacklistedBatch(wctx, &types.QueryIsBlacklistedBatchRequest{
+		Denom:     testDenom,
+		Addresses: []string{msgs[0].address, other, msgs[1].address},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.IsBlacklistedResult{
+		{Address: msgs[0].address, Blacklisted: true},
+		{Address: other, Blacklisted: false},
+		{Address: msgs[1].address, Blacklisted: true},
+	}, resp.Results)
+
+	_, err = keeper.IsBlacklistedBatch(wctx, &types.QueryIsBlacklistedBatchRequest{
+		Denom:     testDenom,
+		Addresses: []string{"invalid_address"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = keeper.IsBlacklistedBatch(wctx, &types.QueryIsBlacklistedBatchRequest{
+		Denom:     testDenom,
+		Addresses: make([]string, types.MaxIsBlacklistedBatchSize+1),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
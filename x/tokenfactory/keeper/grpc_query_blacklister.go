@@ -15,7 +15,7 @@ func (k Keeper) Blacklister(c context.Context, req *types.QueryGetBlacklisterReq
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	val, found := k.GetBlacklister(ctx)
+	val, found := k.GetBlacklister(ctx, req.Denom)
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) FactoryDenom(c context.Context, req *types.QueryGetFactoryDenomRequest) (*types.QueryGetFactoryDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetFactoryDenom(ctx, req.Denom)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetFactoryDenomResponse{FactoryDenom: val}, nil
+}
+
+func (k Keeper) FactoryDenomsByCreator(c context.Context, req *types.QueryFactoryDenomsByCreatorRequest) (*types.QueryFactoryDenomsByCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Creator); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var factoryDenoms []types.FactoryDenom
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FactoryDenomKeyPrefix))
+	creatorStore := prefix.NewStore(store, types.FactoryDenomCreatorPrefix(req.Creator))
+
+	pageRes, err := query.Paginate(creatorStore, req.Pagination, func(key []byte, value []byte) error {
+		var factoryDenom types.FactoryDenom
+		if err := k.cdc.Unmarshal(value, &factoryDenom); err != nil {
+			return err
+		}
+
+		factoryDenoms = append(factoryDenoms, factoryDenom)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFactoryDenomsByCreatorResponse{FactoryDenoms: factoryDenoms, Pagination: pageRes}, nil
+}
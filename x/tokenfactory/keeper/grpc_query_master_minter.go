@@ -15,7 +15,7 @@ func (k Keeper) MasterMinter(c context.Context, req *types.QueryGetMasterMinterR
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	val, found := k.GetMasterMinter(ctx)
+	val, found := k.GetMasterMinter(ctx, req.Denom)
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
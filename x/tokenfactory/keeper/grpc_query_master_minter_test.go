@@ -25,7 +25,7 @@ func TestMasterMinterQuery(t *testing.T) {
 	}{
 		{
 			desc:     "First",
-			request:  &types.QueryGetMasterMinterRequest{},
+			request:  &types.QueryGetMasterMinterRequest{Denom: testDenom},
 			response: &types.QueryGetMasterMinterResponse{MasterMinter: item},
 		},
 		{
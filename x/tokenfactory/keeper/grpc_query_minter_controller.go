@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
 
@@ -20,7 +21,7 @@ func (k Keeper) MinterControllerAll(c context.Context, req *types.QueryAllMinter
 	var minterControllers []types.MinterController
 	ctx := sdk.UnwrapSDKContext(c)
 
-	store := ctx.KVStore(k.storeKey)
+	store := k.denomStore(ctx, req.Denom)
 	minterControllerStore := prefix.NewStore(store, types.KeyPrefix(types.MinterControllerKeyPrefix))
 
 	pageRes, err := query.Paginate(minterControllerStore, req.Pagination, func(key []byte, value []byte) error {
@@ -46,9 +47,23 @@ func (k Keeper) MinterController(c context.Context, req *types.QueryGetMinterCon
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
+	if req.MinterAddress == "" {
+		minterControllers := k.GetMinterControllersByController(ctx, req.Denom, req.ControllerAddress)
+		switch len(minterControllers) {
+		case 0:
+			return nil, status.Error(codes.NotFound, "not found")
+		case 1:
+			return &types.QueryGetMinterControllerResponse{MinterController: minterControllers[0]}, nil
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "controller manages %d minters, specify the minter", len(minterControllers))
+		}
+	}
+
 	val, found := k.GetMinterController(
 		ctx,
+		req.Denom,
 		req.ControllerAddress,
+		req.MinterAddress,
 	)
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
@@ -56,3 +71,61 @@ func (k Keeper) MinterController(c context.Context, req *types.QueryGetMinterCon
 
 	return &types.QueryGetMinterControllerResponse{MinterController: val}, nil
 }
+
+func (k Keeper) MinterControllersByController(c context.Context, req *types.QueryMinterControllersByControllerRequest) (*types.QueryMinterControllersByControllerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var minterControllers []types.MinterController
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := k.denomStore(ctx, req.Denom)
+	minterControllerStore := prefix.NewStore(store, append(types.KeyPrefix(types.MinterControllerKeyPrefix), types.MinterControllerControllerPrefix(req.ControllerAddress)...))
+
+	pageRes, err := query.Paginate(minterControllerStore, req.Pagination, func(key []byte, value []byte) error {
+		var minterController types.MinterController
+		if err := k.cdc.Unmarshal(value, &minterController); err != nil {
+			return err
+		}
+
+		minterControllers = append(minterControllers, minterController)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMinterControllersByControllerResponse{MinterController: minterControllers, Pagination: pageRes}, nil
+}
+
+func (k Keeper) MinterControllersByMinter(c context.Context, req *types.QueryMinterControllersByMinterRequest) (*types.QueryMinterControllersByMinterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var minterControllers []types.MinterController
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := k.denomStore(ctx, req.Denom)
+	byMinterStore := prefix.NewStore(store, append(types.KeyPrefix(types.MinterControllerByMinterKeyPrefix), types.MinterControllerByMinterPrefix(req.MinterAddress)...))
+
+	pageRes, err := query.Paginate(byMinterStore, req.Pagination, func(key []byte, _ []byte) error {
+		// the key is the controller address followed by a slash
+		controller := string(key[:len(key)-1])
+		minterController, found := k.GetMinterController(ctx, req.Denom, controller, req.MinterAddress)
+		if !found {
+			return fmt.Errorf("minter controller %s of minter %s not found", controller, req.MinterAddress)
+		}
+
+		minterControllers = append(minterControllers, minterController)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMinterControllersByMinterResponse{MinterController: minterControllers, Pagination: pageRes}, nil
+}
@@ -31,6 +31,7 @@ func TestMinterControllerQuerySingle(t *testing.T) {
 		{
 			desc: "First",
 			request: &types.QueryGetMinterControllerRequest{
+				Denom:             testDenom,
 				ControllerAddress: msgs[0].Controller,
 			},
 			response: &types.QueryGetMinterControllerResponse{MinterController: msgs[0]},
@@ -38,17 +39,37 @@ func TestMinterControllerQuerySingle(t *testing.T) {
 		{
 			desc: "Second",
 			request: &types.QueryGetMinterControllerRequest{
+				Denom:             testDenom,
 				ControllerAddress: msgs[1].Controller,
 			},
 			response: &types.QueryGetMinterControllerResponse{MinterController: msgs[1]},
 		},
+		{
+			desc: "ByMinter",
+			request: &types.QueryGetMinterControllerRequest{
+				Denom:             testDenom,
+				ControllerAddress: msgs[1].Controller,
+				MinterAddress:     msgs[1].Minter,
+			},
+			response: &types.QueryGetMinterControllerResponse{MinterController: msgs[1]},
+		},
 		{
 			desc: "KeyNotFound",
 			request: &types.QueryGetMinterControllerRequest{
+				Denom:             testDenom,
 				ControllerAddress: strconv.Itoa(100000),
 			},
 			err: status.Error(codes.NotFound, "not found"),
 		},
+		{
+			desc: "MinterNotFound",
+			request: &types.QueryGetMinterControllerRequest{
+				Denom:             testDenom,
+				ControllerAddress: msgs[0].Controller,
+				MinterAddress:     msgs[1].Minter,
+			},
+			err: status.Error(codes.NotFound, "not found"),
+		},
 		{
 			desc: "InvalidRequest",
 			err:  status.Error(codes.InvalidArgument, "invalid request"),
@@ -76,6 +97,7 @@ func TestMinterControllerQueryPaginated(t *testing.T) {
 
 	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllMinterControllerRequest {
 		return &types.QueryAllMinterControllerRequest{
+			Denom: testDenom,
 			Pagination: &query.PageRequest{
 				Key:        next,
 				Offset:     offset,
@@ -124,3 +146,47 @@ func TestMinterControllerQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestMinterControllerQueryMultipleMinters(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	items := []types.MinterController{
+		{Controller: "a", Minter: "x"},
+		{Controller: "a", Minter: "y"},
+		{Controller: "b", Minter: "x"},
+	}
+	for _, item := range items {
+		keeper.SetMinterController(ctx, testDenom, item)
+	}
+
+	_, err := keeper.MinterController(wctx, &types.QueryGetMinterControllerRequest{Denom: testDenom, ControllerAddress: "a"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	byController, err := keeper.MinterControllersByController(wctx, &types.QueryMinterControllersByControllerRequest{
+		Denom:             testDenom,
+		ControllerAddress: "a",
+		Pagination:        &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), byController.Pagination.Total)
+	require.ElementsMatch(t, items[:2], byController.MinterController)
+
+	byMinter, err := keeper.MinterControllersByMinter(wctx, &types.QueryMinterControllersByMinterRequest{
+		Denom:         testDenom,
+		MinterAddress: "x",
+		Pagination:    &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, items[:1], byMinter.MinterController)
+
+	byMinter, err = keeper.MinterControllersByMinter(wctx, &types.QueryMinterControllersByMinterRequest{
+		Denom:         testDenom,
+		MinterAddress: "x",
+		Pagination:    &query.PageRequest{Key: byMinter.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Equal(t, items[2:], byMinter.MinterController)
+
+	_, err = keeper.MinterControllersByMinter(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -19,7 +19,7 @@ func (k Keeper) MintersAll(c context.Context, req *types.QueryAllMintersRequest)
 	var minters []types.Minters
 	ctx := sdk.UnwrapSDKContext(c)
 
-	store := ctx.KVStore(k.storeKey)
+	store := k.denomStore(ctx, req.Denom)
 	mintersStore := prefix.NewStore(store, types.KeyPrefix(types.MintersKeyPrefix))
 
 	pageRes, err := query.Paginate(mintersStore, req.Pagination, func(key []byte, value []byte) error {
@@ -47,6 +47,7 @@ func (k Keeper) Minters(c context.Context, req *types.QueryGetMintersRequest) (*
 
 	val, found := k.GetMinters(
 		ctx,
+		req.Denom,
 		req.Address,
 	)
 	if !found {
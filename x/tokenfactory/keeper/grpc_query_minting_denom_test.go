@@ -25,7 +25,7 @@ func TestMintingDenomQuery(t *testing.T) {
 	}{
 		{
 			desc:     "First",
-			request:  &types.QueryGetMintingDenomRequest{},
+			request:  &types.QueryGetMintingDenomRequest{Denom: testDenom},
 			response: &types.QueryGetMintingDenomResponse{MintingDenom: item},
 		},
 		{
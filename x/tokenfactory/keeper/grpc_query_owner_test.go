@@ -17,7 +17,7 @@ func TestOwnerQuery(t *testing.T) {
 	keeper, ctx := keepertest.TokenfactoryKeeper(t)
 	wctx := sdk.WrapSDKContext(ctx)
 	owner := types.Owner{Address: "test"}
-	keeper.SetOwner(ctx, owner)
+	keeper.SetOwner(ctx, testDenom, owner)
 	for _, tc := range []struct {
 		desc     string
 		request  *types.QueryGetOwnerRequest
@@ -26,7 +26,7 @@ func TestOwnerQuery(t *testing.T) {
 	}{
 		{
 			desc:     "First",
-			request:  &types.QueryGetOwnerRequest{},
+			request:  &types.QueryGetOwnerRequest{Denom: testDenom},
 			response: &types.QueryGetOwnerResponse{Owner: owner},
 		},
 		{
@@ -25,7 +25,7 @@ func TestPausedQuery(t *testing.T) {
 	}{
 		{
 			desc:     "First",
-			request:  &types.QueryGetPausedRequest{},
+			request:  &types.QueryGetPausedRequest{Denom: testDenom},
 			response: &types.QueryGetPausedResponse{Paused: item},
 		},
 		{
@@ -15,7 +15,7 @@ func (k Keeper) Pauser(c context.Context, req *types.QueryGetPauserRequest) (*ty
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	val, found := k.GetPauser(ctx)
+	val, found := k.GetPauser(ctx, req.Denom)
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
@@ -25,7 +25,7 @@ func TestPauserQuery(t *testing.T) {
 	}{
 		{
 			desc:     "First",
-			request:  &types.QueryGetPauserRequest{},
+			request:  &types.QueryGetPauserRequest{Denom: testDenom},
 			response: &types.QueryGetPauserResponse{Pauser: item},
 		},
 		{
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PendingMint(c context.Context, req *types.QueryGetPendingMintRequest) (*types.QueryGetPendingMintResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetPendingMint(ctx, req.Denom, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetPendingMintResponse{PendingMint: val}, nil
+}
+
+func (k Keeper) PendingMints(c context.Context, req *types.QueryPendingMintsRequest) (*types.QueryPendingMintsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var pendingMints []types.PendingMint
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(k.denomStore(ctx, req.Denom), types.KeyPrefix(types.PendingMintKeyPrefix))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var pendingMint types.PendingMint
+		if err := k.cdc.Unmarshal(value, &pendingMint); err != nil {
+			return err
+		}
+
+		pendingMints = append(pendingMints, pendingMint)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingMintsResponse{PendingMints: pendingMints, Pagination: pageRes}, nil
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Redemption(c context.Context, req *types.QueryGetRedemptionRequest) (*types.QueryGetRedemptionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetRedemption(ctx, req.Denom, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetRedemptionResponse{Redemption: val}, nil
+}
+
+func (k Keeper) Redemptions(c context.Context, req *types.QueryRedemptionsRequest) (*types.QueryRedemptionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var redemptions []types.Redemption
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(k.denomStore(ctx, req.Denom), types.KeyPrefix(types.RedemptionKeyPrefix))
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var redemption types.Redemption
+		if err := k.cdc.Unmarshal(value, &redemption); err != nil {
+			return false, err
+		}
+
+		if req.Status != types.REDEMPTION_STATUS_UNSPECIFIED && redemption.Status != req.Status {
+			return false, nil
+		}
+
+		if req.Redeemer != "" && redemption.Redeemer != req.Redeemer {
+			return false, nil
+		}
+
+		if accumulate {
+			redemptions = append(redemptions, redemption)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRedemptionsResponse{Redemptions: redemptions, Pagination: pageRes}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -19,7 +20,8 @@ type (
 		storeKey   storetypes.StoreKey
 		paramstore paramtypes.Subspace
 
-		bankKeeper types.BankKeeper
+		bankKeeper   types.BankKeeper
+		paramsKeeper types.ParamsKeeper
 	}
 )
 
@@ -29,6 +31,7 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 
 	bankKeeper types.BankKeeper,
+	paramsKeeper types.ParamsKeeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -37,43 +40,54 @@ func NewKeeper(
 
 	return &Keeper{
 
-		cdc:        cdc,
-		storeKey:   storeKey,
-		paramstore: ps,
-		bankKeeper: bankKeeper,
+		cdc:          cdc,
+		storeKey:     storeKey,
+		paramstore:   ps,
+		bankKeeper:   bankKeeper,
+		paramsKeeper: paramsKeeper,
 	}
 }
 
+// denomStore returns the store of the state of a minting denom.
+func (k Keeper) denomStore(ctx sdk.Context, denom string) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomPrefix(denom))
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-// ValidatePrivileges checks if a specified address has already been assigned to a privileged role.
-func (k Keeper) ValidatePrivileges(ctx sdk.Context, address string) error {
+// ValidatePrivileges checks if a specified address has already been assigned to a privileged role of a minting denom.
+func (k Keeper) ValidatePrivileges(ctx sdk.Context, denom string, address string) error {
 	acc, err := sdk.AccAddressFromBech32(address)
 	if err != nil {
 		return err
 	}
 
-	owner, found := k.GetOwner(ctx)
+	owner, found := k.GetOwner(ctx, denom)
 	if found && owner.Address == acc.String() {
 		return sdkerrors.Wrapf(types.ErrAlreadyPrivileged, "cannot assign (%s) to owner role", acc.String())
 	}
 
-	blacklister, found := k.GetBlacklister(ctx)
+	blacklister, found := k.GetBlacklister(ctx, denom)
 	if found && blacklister.Address == acc.String() {
 		return sdkerrors.Wrapf(types.ErrAlreadyPrivileged, "cannot assign (%s) to black lister role", acc.String())
 	}
 
-	masterminter, found := k.GetMasterMinter(ctx)
+	masterminter, found := k.GetMasterMinter(ctx, denom)
 	if found && masterminter.Address == acc.String() {
 		return sdkerrors.Wrapf(types.ErrAlreadyPrivileged, "cannot assign (%s) to master minter role", acc.String())
 	}
 
-	pauser, found := k.GetPauser(ctx)
+	pauser, found := k.GetPauser(ctx, denom)
 	if found && pauser.Address == acc.String() {
 		return sdkerrors.Wrapf(types.ErrAlreadyPrivileged, "cannot assign (%s) to pauser role", acc.String())
 	}
 
+	attestor, found := k.GetAttestor(ctx, denom)
+	if found && attestor.Address == acc.String() {
+		return sdkerrors.Wrapf(types.ErrAlreadyPrivileged, "cannot assign (%s) to attestor role", acc.String())
+	}
+
 	return nil
 }
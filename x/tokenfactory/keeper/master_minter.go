@@ -7,15 +7,15 @@ import (
 )
 
 // SetMasterMinter set masterMinter in the store
-func (k Keeper) SetMasterMinter(ctx sdk.Context, masterMinter types.MasterMinter) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) SetMasterMinter(ctx sdk.Context, denom string, masterMinter types.MasterMinter) {
+	store := k.denomStore(ctx, denom)
 	b := k.cdc.MustMarshal(&masterMinter)
 	store.Set(types.KeyPrefix(types.MasterMinterKey), b)
 }
 
 // GetMasterMinter returns masterMinter
-func (k Keeper) GetMasterMinter(ctx sdk.Context) (val types.MasterMinter, found bool) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) GetMasterMinter(ctx sdk.Context, denom string) (val types.MasterMinter, found bool) {
+	store := k.denomStore(ctx, denom)
 
 	b := store.Get(types.KeyPrefix(types.MasterMinterKey))
 	if b == nil {
@@ -14,14 +14,14 @@ import (
 
 func createTestMasterMinter(keeper *keeper.Keeper, ctx sdk.Context) types.MasterMinter {
 	item := types.MasterMinter{}
-	keeper.SetMasterMinter(ctx, item)
+	keeper.SetMasterMinter(ctx, testDenom, item)
 	return item
 }
 
 func TestMasterMinterGet(t *testing.T) {
 	keeper, ctx := keepertest.TokenfactoryKeeper(t)
 	item := createTestMasterMinter(keeper, ctx)
-	rst, found := keeper.GetMasterMinter(ctx)
+	rst, found := keeper.GetMasterMinter(ctx, testDenom)
 	require.True(t, found)
 	require.Equal(t,
 		nullify.Fill(&item),
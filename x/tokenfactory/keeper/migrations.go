@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the params, which were empty until now, to their defaults.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.SetParams(ctx, types.DefaultParams())
+	return nil
+}
+
+// Migrate2to3 sets the travel rule params to their defaults, which leave the
+// travel rule disabled.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	m.keeper.paramstore.Set(ctx, types.KeyTravelRuleEnabled, defaults.TravelRuleEnabled)
+	m.keeper.paramstore.Set(ctx, types.KeyTravelRuleThreshold, defaults.TravelRuleThreshold)
+	return nil
+}
+
+// Migrate3to4 moves the MinterControllers, which were keyed by controller
+// only, under their controller and minter pair and indexes them by minter.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	store := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.KeyPrefix(types.MinterControllerKeyPrefix))
+
+	var keys [][]byte
+	var minterControllers []types.MinterController
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var minterController types.MinterController
+		if err := m.keeper.cdc.Unmarshal(iterator.Value(), &minterController); err != nil {
+			iterator.Close()
+			return err
+		}
+		keys = append(keys, iterator.Key())
+		minterControllers = append(minterControllers, minterController)
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	// the store is not split by denom until version 5
+	byMinterStore := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.KeyPrefix(types.MinterControllerByMinterKeyPrefix))
+	for _, minterController := range minterControllers {
+		b := m.keeper.cdc.MustMarshal(&minterController)
+		store.Set(types.MinterControllerKey(minterController.Controller, minterController.Minter), b)
+		byMinterStore.Set(types.MinterControllerByMinterKey(minterController.Minter, minterController.Controller), []byte{})
+	}
+
+	return nil
+}
+
+// Migrate4to5 registers the minting denom, which was a singleton, as the
+// first of the minting denoms and moves all the state, which was global,
+// under it.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+
+	legacyMintingDenomStore := prefix.NewStore(store, types.KeyPrefix(types.MintingDenomKey))
+	b := legacyMintingDenomStore.Get(types.KeyPrefix(types.MintingDenomKey))
+	if b == nil {
+		return nil
+	}
+	legacyMintingDenomStore.Delete(types.KeyPrefix(types.MintingDenomKey))
+
+	var mintingDenom types.MintingDenom
+	if err := m.keeper.cdc.Unmarshal(b, &mintingDenom); err != nil {
+		return err
+	}
+	mintingDenomStore := prefix.NewStore(store, types.KeyPrefix(types.MintingDenomKeyPrefix))
+	mintingDenomStore.Set(types.MintingDenomsKey(mintingDenom.Denom), b)
+
+	for _, p := range []string{
+		types.PausedKey,
+		types.MasterMinterKey,
+		types.PauserKey,
+		types.BlacklisterKey,
+		types.OwnerKey,
+		types.PendingOwnerKey,
+		types.BlacklistedKeyPrefix,
+		types.MintersKeyPrefix,
+		types.MinterControllerKeyPrefix,
+		types.MinterControllerByMinterKeyPrefix,
+	} {
+		var keys, values [][]byte
+		iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefix(p))
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+			values = append(values, iterator.Value())
+		}
+		iterator.Close()
+
+		for i, key := range keys {
+			store.Delete(key)
+			store.Set(append(types.DenomPrefix(mintingDenom.Denom), key...), values[i])
+		}
+	}
+
+	return nil
+}
+
+// Migrate5to6 sets the denom creation fee of factory denoms to its default,
+// which makes their creation free.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	m.keeper.paramstore.Set(ctx, types.KeyDenomCreationFee, types.DefaultParams().DenomCreationFee)
+	return nil
+}
+
+// Migrate6to7 sets the MintWithinReservesDenoms param to its default, which
+// leaves the minting of every denom unbounded by attested reserves.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	m.keeper.paramstore.Set(ctx, types.KeyMintWithinReservesDenoms, types.DefaultParams().MintWithinReservesDenoms)
+	return nil
+}
+
+// Migrate7to8 sets the mint approval params to their defaults, which set no
+// approval threshold for any minting denom.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	m.keeper.paramstore.Set(ctx, types.KeyMintApprovalThresholds, defaults.MintApprovalThresholds)
+	m.keeper.paramstore.Set(ctx, types.KeyMintApprovalWindow, defaults.MintApprovalWindow)
+	return nil
+}
+
+// Migrate8to9 sets the policy limit params to their defaults, which limit
+// neither minting nor the number of minters, and keep rejecting the IBC
+// transfers to blacklisted addresses.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	m.keeper.paramstore.Set(ctx, types.KeyMaxMintAmounts, defaults.MaxMintAmounts)
+	m.keeper.paramstore.Set(ctx, types.KeySupplyCaps, defaults.SupplyCaps)
+	m.keeper.paramstore.Set(ctx, types.KeyMaxMinters, defaults.MaxMinters)
+	m.keeper.paramstore.Set(ctx, types.KeyAllowBlacklistedIBCReceive, defaults.AllowBlacklistedIbcReceive)
+	return nil
+}
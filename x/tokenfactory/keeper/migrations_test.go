@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestMigrate4to5(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey(types.ModuleName + "_transient")
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(tStoreKey, storetypes.StoreTypeTransient, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	paramsSubspace := typesparams.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, tStoreKey, "TokenfactoryParams")
+	k := NewKeeper(cdc, storeKey, paramsSubspace, nil, nil)
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+	// nothing to migrate without a minting denom
+	require.NoError(t, NewMigrator(k).Migrate4to5(ctx))
+	require.Empty(t, k.GetAllMintingDenoms(ctx))
+
+	// the singleton state of version 4
+	root := ctx.KVStore(storeKey)
+	prefix.NewStore(root, types.KeyPrefix(types.MintingDenomKey)).
+		Set(types.KeyPrefix(types.MintingDenomKey), cdc.MustMarshal(&types.MintingDenom{Denom: "utoken"}))
+	root.Set(types.KeyPrefix(types.OwnerKey), cdc.MustMarshal(&types.Owner{Address: "owner"}))
+	root.Set(types.KeyPrefix(types.PausedKey), cdc.MustMarshal(&types.Paused{Paused: true}))
+	prefix.NewStore(root, types.KeyPrefix(types.BlacklistedKeyPrefix)).
+		Set(types.BlacklistedKey([]byte("address")), cdc.MustMarshal(&types.Blacklisted{AddressBz: []byte("address")}))
+	prefix.NewStore(root, types.KeyPrefix(types.MintersKeyPrefix)).
+		Set(types.MintersKey("minter"), cdc.MustMarshal(&types.Minters{Address: "minter", Allowance: sdk.NewInt64Coin("utoken", 1)}))
+	mc := types.MinterController{Controller: "controller", Minter: "minter"}
+	prefix.NewStore(root, types.KeyPrefix(types.MinterControllerKeyPrefix)).
+		Set(types.MinterControllerKey(mc.Controller, mc.Minter), cdc.MustMarshal(&mc))
+	prefix.NewStore(root, types.KeyPrefix(types.MinterControllerByMinterKeyPrefix)).
+		Set(types.MinterControllerByMinterKey(mc.Minter, mc.Controller), []byte{})
+
+	require.NoError(t, NewMigrator(k).Migrate4to5(ctx))
+
+	require.Equal(t, []types.MintingDenom{{Denom: "utoken"}}, k.GetAllMintingDenoms(ctx))
+	owner, found := k.GetOwner(ctx, "utoken")
+	require.True(t, found)
+	require.Equal(t, "owner", owner.Address)
+	require.True(t, k.GetPaused(ctx, "utoken").Paused)
+	_, found = k.GetBlacklisted(ctx, "utoken", []byte("address"))
+	require.True(t, found)
+	_, found = k.GetMinters(ctx, "utoken", "minter")
+	require.True(t, found)
+	require.Equal(t, []types.MinterController{mc}, k.GetMinterControllersByMinter(ctx, "utoken", "minter"))
+
+	// only the minting denom registry and its state are left at the root
+	iterator := root.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		key := string(iterator.Key())
+		require.True(t, strings.HasPrefix(key, types.DenomKeyPrefix) || strings.HasPrefix(key, types.MintingDenomKeyPrefix), key)
+	}
+}
+
+func TestMigrate5to9(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey(types.ModuleName + "_transient")
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(tStoreKey, storetypes.StoreTypeTransient, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	paramsSubspace := typesparams.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, tStoreKey, "TokenfactoryParams")
+	k := NewKeeper(cdc, storeKey, paramsSubspace, nil, nil)
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+	// the params of version 5
+	defaults := types.DefaultParams()
+	k.paramstore.Set(ctx, types.KeyRejectBlacklistedSigners, defaults.RejectBlacklistedSigners)
+	k.paramstore.Set(ctx, types.KeyTravelRuleEnabled, defaults.TravelRuleEnabled)
+	k.paramstore.Set(ctx, types.KeyTravelRuleThreshold, defaults.TravelRuleThreshold)
+	require.Panics(t, func() { k.GetParams(ctx) })
+
+	require.NoError(t, NewMigrator(k).Migrate5to6(ctx))
+	require.Equal(t, defaults.DenomCreationFee, k.DenomCreationFee(ctx))
+
+	// the params of version 6 miss MintWithinReservesDenoms
+	require.Panics(t, func() { k.GetParams(ctx) })
+	require.NoError(t, NewMigrator(k).Migrate6to7(ctx))
+	require.False(t, k.MintWithinReserves(ctx, "utoken"))
+
+	// the params of version 7 miss the mint approval ones
+	require.Panics(t, func() { k.GetParams(ctx) })
+	require.NoError(t, NewMigrator(k).Migrate7to8(ctx))
+	require.Equal(t, defaults.MintApprovalWindow, k.MintApprovalWindow(ctx))
+
+	// the params of version 8 miss the policy limit ones
+	require.Panics(t, func() { k.GetParams(ctx) })
+	require.NoError(t, NewMigrator(k).Migrate8to9(ctx))
+	require.Equal(t, defaults, k.GetParams(ctx))
+}
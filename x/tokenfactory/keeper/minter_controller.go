@@ -8,24 +8,33 @@ import (
 )
 
 // SetMinterController set a specific minterController in the store from its index
-func (k Keeper) SetMinterController(ctx sdk.Context, minterController types.MinterController) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MinterControllerKeyPrefix))
+func (k Keeper) SetMinterController(ctx sdk.Context, denom string, minterController types.MinterController) {
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.MinterControllerKeyPrefix))
 	b := k.cdc.MustMarshal(&minterController)
 	store.Set(types.MinterControllerKey(
 		minterController.Controller,
+		minterController.Minter,
 	), b)
+
+	byMinterStore := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.MinterControllerByMinterKeyPrefix))
+	byMinterStore.Set(types.MinterControllerByMinterKey(
+		minterController.Minter,
+		minterController.Controller,
+	), []byte{})
 }
 
 // GetMinterController returns a minterController from its index
 func (k Keeper) GetMinterController(
 	ctx sdk.Context,
+	denom string,
 	controller string,
-
+	minter string,
 ) (val types.MinterController, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MinterControllerKeyPrefix))
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.MinterControllerKeyPrefix))
 
 	b := store.Get(types.MinterControllerKey(
 		controller,
+		minter,
 	))
 	if b == nil {
 		return val, false
@@ -38,18 +47,59 @@ func (k Keeper) GetMinterController(
 // RemoveMinterController removes a minterController from the store
 func (k Keeper) DeleteMinterController(
 	ctx sdk.Context,
+	denom string,
 	controller string,
-
+	minter string,
 ) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MinterControllerKeyPrefix))
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.MinterControllerKeyPrefix))
 	store.Delete(types.MinterControllerKey(
 		controller,
+		minter,
+	))
+
+	byMinterStore := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.MinterControllerByMinterKeyPrefix))
+	byMinterStore.Delete(types.MinterControllerByMinterKey(
+		minter,
+		controller,
 	))
 }
 
+// GetMinterControllersByController returns the minterControllers of a controller
+func (k Keeper) GetMinterControllersByController(ctx sdk.Context, denom string, controller string) (list []types.MinterController) {
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.MinterControllerKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.MinterControllerControllerPrefix(controller))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MinterController
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetMinterControllersByMinter returns the minterControllers of the controllers of a minter
+func (k Keeper) GetMinterControllersByMinter(ctx sdk.Context, denom string, minter string) (list []types.MinterController) {
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.MinterControllerByMinterKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.MinterControllerByMinterPrefix(minter))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		controller := string(iterator.Key()[len(types.MinterControllerByMinterPrefix(minter)) : len(iterator.Key())-1])
+		if val, found := k.GetMinterController(ctx, denom, controller, minter); found {
+			list = append(list, val)
+		}
+	}
+
+	return
+}
+
 // GetAllMinterController returns all minterController
-func (k Keeper) GetAllMinterControllers(ctx sdk.Context) (list []types.MinterController) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MinterControllerKeyPrefix))
+func (k Keeper) GetAllMinterControllers(ctx sdk.Context, denom string) (list []types.MinterController) {
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.MinterControllerKeyPrefix))
 	iterator := sdk.KVStorePrefixIterator(store, []byte{})
 
 	defer iterator.Close()
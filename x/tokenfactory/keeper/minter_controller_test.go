@@ -20,8 +20,9 @@ func createNMinterController(keeper *keeper.Keeper, ctx sdk.Context, n int) []ty
 	items := make([]types.MinterController, n)
 	for i := range items {
 		items[i].Controller = strconv.Itoa(i)
+		items[i].Minter = strconv.Itoa(i)
 
-		keeper.SetMinterController(ctx, items[i])
+		keeper.SetMinterController(ctx, testDenom, items[i])
 	}
 	return items
 }
@@ -30,8 +31,9 @@ func TestMinterControllerGet(t *testing.T) {
 	keeper, ctx := keepertest.TokenfactoryKeeper(t)
 	items := createNMinterController(keeper, ctx, 10)
 	for _, item := range items {
-		rst, found := keeper.GetMinterController(ctx,
+		rst, found := keeper.GetMinterController(ctx, testDenom,
 			item.Controller,
+			item.Minter,
 		)
 		require.True(t, found)
 		require.Equal(t,
@@ -44,21 +46,46 @@ func TestMinterControllerRemove(t *testing.T) {
 	keeper, ctx := keepertest.TokenfactoryKeeper(t)
 	items := createNMinterController(keeper, ctx, 10)
 	for _, item := range items {
-		keeper.DeleteMinterController(ctx,
+		keeper.DeleteMinterController(ctx, testDenom,
+			item.Controller,
 			item.Minter,
 		)
-		_, found := keeper.GetMinterController(ctx,
+		_, found := keeper.GetMinterController(ctx, testDenom,
+			item.Controller,
 			item.Minter,
 		)
 		require.False(t, found)
+		require.Empty(t, keeper.GetMinterControllersByMinter(ctx, testDenom, item.Minter))
 	}
 }
 
+func TestMinterControllerOneToMany(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	allowanceCap := sdk.NewInt64Coin("uusdc", 10)
+	items := []types.MinterController{
+		{Controller: "a", Minter: "x", AllowanceCap: &allowanceCap},
+		{Controller: "a", Minter: "y"},
+		{Controller: "ab", Minter: "x"},
+		{Controller: "b", Minter: "x"},
+	}
+	for _, item := range items {
+		keeper.SetMinterController(ctx, testDenom, item)
+	}
+
+	require.ElementsMatch(t, items[:2], keeper.GetMinterControllersByController(ctx, testDenom, "a"))
+	require.ElementsMatch(t, []types.MinterController{items[0], items[2], items[3]}, keeper.GetMinterControllersByMinter(ctx, testDenom, "x"))
+	require.ElementsMatch(t, items[1:2], keeper.GetMinterControllersByMinter(ctx, testDenom, "y"))
+
+	keeper.DeleteMinterController(ctx, testDenom, "a", "x")
+	require.ElementsMatch(t, items[1:2], keeper.GetMinterControllersByController(ctx, testDenom, "a"))
+	require.ElementsMatch(t, items[2:], keeper.GetMinterControllersByMinter(ctx, testDenom, "x"))
+}
+
 func TestMinterControllerGetAll(t *testing.T) {
 	keeper, ctx := keepertest.TokenfactoryKeeper(t)
 	items := createNMinterController(keeper, ctx, 10)
 	require.ElementsMatch(t,
 		nullify.Fill(items),
-		nullify.Fill(keeper.GetAllMinterControllers(ctx)),
+		nullify.Fill(keeper.GetAllMinterControllers(ctx, testDenom)),
 	)
 }
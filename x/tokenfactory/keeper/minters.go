@@ -7,8 +7,8 @@ import (
 )
 
 // SetMinters set a specific minters in the store from its index
-func (k Keeper) SetMinters(ctx sdk.Context, minters types.Minters) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintersKeyPrefix))
+func (k Keeper) SetMinters(ctx sdk.Context, denom string, minters types.Minters) {
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.MintersKeyPrefix))
 	b := k.cdc.MustMarshal(&minters)
 	store.Set(types.MintersKey(
 		minters.Address,
@@ -18,10 +18,11 @@ func (k Keeper) SetMinters(ctx sdk.Context, minters types.Minters) {
 // GetMinters returns a minters from its index
 func (k Keeper) GetMinters(
 	ctx sdk.Context,
+	denom string,
 	address string,
 
 ) (val types.Minters, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintersKeyPrefix))
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.MintersKeyPrefix))
 
 	b := store.Get(types.MintersKey(
 		address,
@@ -37,18 +38,19 @@ func (k Keeper) GetMinters(
 // RemoveMinters removes a minters from the store
 func (k Keeper) RemoveMinters(
 	ctx sdk.Context,
+	denom string,
 	address string,
 
 ) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintersKeyPrefix))
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.MintersKeyPrefix))
 	store.Delete(types.MintersKey(
 		address,
 	))
 }
 
 // GetAllMinters returns all minters
-func (k Keeper) GetAllMinters(ctx sdk.Context) (list []types.Minters) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintersKeyPrefix))
+func (k Keeper) GetAllMinters(ctx sdk.Context, denom string) (list []types.Minters) {
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.MintersKeyPrefix))
 	iterator := sdk.KVStorePrefixIterator(store, []byte{})
 
 	defer iterator.Close()
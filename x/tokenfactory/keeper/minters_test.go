@@ -20,7 +20,7 @@ func createNMinters(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Minte
 	for i := range items {
 		items[i].Address = strconv.Itoa(i)
 
-		keeper.SetMinters(ctx, items[i])
+		keeper.SetMinters(ctx, testDenom, items[i])
 	}
 	return items
 }
@@ -29,7 +29,7 @@ func TestMintersGet(t *testing.T) {
 	keeper, ctx := keepertest.TokenfactoryKeeper(t)
 	items := createNMinters(keeper, ctx, 10)
 	for _, item := range items {
-		rst, found := keeper.GetMinters(ctx,
+		rst, found := keeper.GetMinters(ctx, testDenom,
 			item.Address,
 		)
 		require.True(t, found)
@@ -43,10 +43,10 @@ func TestMintersRemove(t *testing.T) {
 	keeper, ctx := keepertest.TokenfactoryKeeper(t)
 	items := createNMinters(keeper, ctx, 10)
 	for _, item := range items {
-		keeper.RemoveMinters(ctx,
+		keeper.RemoveMinters(ctx, testDenom,
 			item.Address,
 		)
-		_, found := keeper.GetMinters(ctx,
+		_, found := keeper.GetMinters(ctx, testDenom,
 			item.Address,
 		)
 		require.False(t, found)
@@ -58,6 +58,6 @@ func TestMintersGetAll(t *testing.T) {
 	items := createNMinters(keeper, ctx, 10)
 	require.ElementsMatch(t,
 		nullify.Fill(items),
-		nullify.Fill(keeper.GetAllMinters(ctx)),
+		nullify.Fill(keeper.GetAllMinters(ctx, testDenom)),
 	)
 }
@@ -9,9 +9,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// SetMintingDenom set mintingDenom in the store
+// SetMintingDenom set a specific mintingDenom in the store from its index
 func (k *Keeper) SetMintingDenom(ctx sdk.Context, mintingDenom types.MintingDenom) {
-	if k.MintingDenomSet(ctx) {
+	if k.HasMintingDenom(ctx, mintingDenom.Denom) {
 		panic(types.ErrMintingDenomSet)
 	}
 
@@ -20,32 +20,42 @@ func (k *Keeper) SetMintingDenom(ctx sdk.Context, mintingDenom types.MintingDeno
 		panic(fmt.Sprintf("Denom metadata for '%s' should be set", mintingDenom.Denom))
 	}
 
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintingDenomKey))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintingDenomKeyPrefix))
 	b := k.cdc.MustMarshal(&mintingDenom)
-	store.Set(types.KeyPrefix(types.MintingDenomKey), b)
+	store.Set(types.MintingDenomsKey(mintingDenom.Denom), b)
 }
 
-// GetMintingDenom returns mintingDenom
-func (k *Keeper) GetMintingDenom(ctx sdk.Context) (val types.MintingDenom) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintingDenomKey))
+// GetMintingDenom returns a mintingDenom from its index
+func (k *Keeper) GetMintingDenom(ctx sdk.Context, denom string) (val types.MintingDenom, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintingDenomKeyPrefix))
 
-	b := store.Get(types.KeyPrefix(types.MintingDenomKey))
+	b := store.Get(types.MintingDenomsKey(denom))
 	if b == nil {
-		panic("Minting denom is not set")
+		return val, false
 	}
 
 	k.cdc.MustUnmarshal(b, &val)
-	return val
+	return val, true
 }
 
-// MintingDenomSet returns true if the MintingDenom is already set in the store, it returns false otherwise.
-func (k Keeper) MintingDenomSet(ctx sdk.Context) bool {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintingDenomKey))
+// HasMintingDenom returns true if denom is a minting denom of the tokenfactory, it returns false otherwise.
+func (k Keeper) HasMintingDenom(ctx sdk.Context, denom string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintingDenomKeyPrefix))
+	return store.Has(types.MintingDenomsKey(denom))
+}
 
-	b := store.Get(types.KeyPrefix(types.MintingDenomKey))
-	if b == nil {
-		return false
+// GetAllMintingDenoms returns all mintingDenom
+func (k Keeper) GetAllMintingDenoms(ctx sdk.Context) (list []types.MintingDenom) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintingDenomKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MintingDenom
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
 	}
 
-	return true
+	return
 }
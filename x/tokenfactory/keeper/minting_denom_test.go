@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,20 +13,52 @@ import (
 	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
 )
 
+// testDenom is the minting denom whose state the keeper tests set.
+const testDenom = "utoken"
+
 func createTestMintingDenom(keeper *keeper.Keeper, ctx sdk.Context) types.MintingDenom {
 	item := types.MintingDenom{
-		Denom: "abcd",
+		Denom: testDenom,
 	}
 	keeper.SetMintingDenom(ctx, item)
 	return item
 }
 
+func createNMintingDenom(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.MintingDenom {
+	items := make([]types.MintingDenom, n)
+	for i := range items {
+		items[i].Denom = fmt.Sprintf("utoken%d", i)
+		keeper.SetMintingDenom(ctx, items[i])
+	}
+	return items
+}
+
 func TestMintingDenomGet(t *testing.T) {
 	keeper, ctx := keepertest.TokenfactoryKeeper(t)
 	item := createTestMintingDenom(keeper, ctx)
-	rst := keeper.GetMintingDenom(ctx)
+	rst, found := keeper.GetMintingDenom(ctx, testDenom)
+	require.True(t, found)
 	require.Equal(t,
 		nullify.Fill(&item),
 		nullify.Fill(&rst),
 	)
+	require.True(t, keeper.HasMintingDenom(ctx, testDenom))
+	require.False(t, keeper.HasMintingDenom(ctx, "uother"))
+}
+
+func TestMintingDenomGetAll(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNMintingDenom(keeper, ctx, 5)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllMintingDenoms(ctx)),
+	)
+}
+
+func TestMintingDenomSetTwice(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	createTestMintingDenom(keeper, ctx)
+	require.PanicsWithValue(t, types.ErrMintingDenomSet, func() {
+		createTestMintingDenom(keeper, ctx)
+	})
 }
@@ -12,7 +12,7 @@ import (
 func (k msgServer) AcceptOwner(goCtx context.Context, msg *types.MsgAcceptOwner) (*types.MsgAcceptOwnerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	owner, found := k.GetPendingOwner(ctx)
+	owner, found := k.GetPendingOwner(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "pending owner is not set")
 	}
@@ -21,11 +21,17 @@ func (k msgServer) AcceptOwner(goCtx context.Context, msg *types.MsgAcceptOwner)
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the pending owner")
 	}
 
-	k.SetOwner(ctx, owner)
+	previousOwner, _ := k.GetOwner(ctx, msg.Denom)
 
-	k.DeletePendingOwner(ctx)
+	k.SetOwner(ctx, msg.Denom, owner)
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	k.DeletePendingOwner(ctx, msg.Denom)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventOwnerUpdated{
+		Denom:         msg.Denom,
+		PreviousOwner: previousOwner.Address,
+		Owner:         owner.Address,
+	})
 
 	return &types.MsgAcceptOwnerResponse{}, err
 }
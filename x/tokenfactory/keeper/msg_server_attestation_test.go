@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestUpdateAttestor(t *testing.T) {
+	tf, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(tf)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	owner := sample.AccAddress()
+	attestor := sample.AccAddress()
+	tf.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	tf.SetOwner(ctx, testDenom, types.Owner{Address: owner})
+
+	_, err := server.UpdateAttestor(goCtx, types.NewMsgUpdateAttestor(sample.AccAddress(), testDenom, attestor))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.UpdateAttestor(goCtx, types.NewMsgUpdateAttestor(owner, testDenom, owner))
+	require.ErrorIs(t, err, types.ErrAlreadyPrivileged)
+
+	_, err = server.UpdateAttestor(goCtx, types.NewMsgUpdateAttestor(owner, testDenom, attestor))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventAttestorUpdated{
+		Attestor: attestor,
+		Denom:    testDenom,
+	}, lastEvent(t, ctx))
+
+	got, found := tf.GetAttestor(ctx, testDenom)
+	require.True(t, found)
+	require.Equal(t, attestor, got.Address)
+}
+
+func TestSubmitAttestation(t *testing.T) {
+	tf, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(tf)
+
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx = ctx.WithBlockTime(now).WithBlockHeight(10)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	attestor := sample.AccAddress()
+	reserves := sdk.NewInt64Coin(testDenom, 1000)
+	hash := []byte{0x01, 0x02, 0x03}
+
+	_, err := server.SubmitAttestation(goCtx, types.NewMsgSubmitAttestation(attestor, reserves, now, "https://example.com/report", hash))
+	require.ErrorIs(t, err, types.ErrNotMintingDenom)
+
+	tf.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	_, err = server.SubmitAttestation(goCtx, types.NewMsgSubmitAttestation(attestor, reserves, now, "https://example.com/report", hash))
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+
+	tf.SetAttestor(ctx, testDenom, types.Attestor{Address: attestor})
+	_, err = server.SubmitAttestation(goCtx, types.NewMsgSubmitAttestation(sample.AccAddress(), reserves, now, "https://example.com/report", hash))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.SubmitAttestation(goCtx, types.NewMsgSubmitAttestation(attestor, reserves, now.Add(time.Second), "https://example.com/report", hash))
+	require.ErrorIs(t, err, types.ErrInvalidAttestation)
+
+	res, err := server.SubmitAttestation(goCtx, types.NewMsgSubmitAttestation(attestor, reserves, now.Add(-time.Hour), "https://example.com/report", hash))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.Id)
+	require.Equal(t, &types.EventAttestationSubmitted{
+		Id:        0,
+		Attestor:  attestor,
+		Reserves:  reserves,
+		Timestamp: now.Add(-time.Hour),
+		Uri:       "https://example.com/report",
+		Hash:      hash,
+	}, lastEvent(t, ctx))
+
+	_, err = server.SubmitAttestation(goCtx, types.NewMsgSubmitAttestation(attestor, reserves, now.Add(-time.Hour), "https://example.com/report", hash))
+	require.ErrorIs(t, err, types.ErrInvalidAttestation)
+
+	res, err = server.SubmitAttestation(goCtx, types.NewMsgSubmitAttestation(attestor, reserves.AddAmount(sdk.NewInt(500)), now, "https://example.com/report2", hash))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Id)
+
+	latest, found := tf.GetLatestAttestation(ctx, testDenom)
+	require.True(t, found)
+	require.Equal(t, uint64(1), latest.Id)
+	require.Equal(t, int64(10), latest.Height)
+	require.Len(t, tf.GetAllAttestations(ctx, testDenom), 2)
+
+	reservesRes, err := tf.Reserves(goCtx, &types.QueryReservesRequest{Denom: testDenom})
+	require.NoError(t, err)
+	require.Equal(t, &latest, reservesRes.Attestation)
+	require.True(t, reservesRes.Backed)
+}
+
+func TestMintWithinReserves(t *testing.T) {
+	tf, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(tf)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	minter := sample.AccAddress()
+	receiver := sample.AccAddress()
+	tf.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	tf.SetPaused(ctx, testDenom, types.Paused{Paused: false})
+	tf.SetMinters(ctx, testDenom, types.Minters{Address: minter, Allowance: sdk.NewInt64Coin(testDenom, 1000)})
+
+	// only the listed denoms are bounded by their reserves
+	params := types.DefaultParams()
+	params.MintWithinReservesDenoms = []string{"uother"}
+	tf.SetParams(ctx, params)
+
+	_, err := server.Mint(goCtx, types.NewMsgMint(minter, receiver, sdk.NewInt64Coin(testDenom, 100)))
+	require.NoError(t, err)
+
+	params.MintWithinReservesDenoms = []string{testDenom}
+	tf.SetParams(ctx, params)
+
+	_, err = server.Mint(goCtx, types.NewMsgMint(minter, receiver, sdk.NewInt64Coin(testDenom, 100)))
+	require.ErrorIs(t, err, types.ErrMint)
+
+	tf.AppendAttestation(ctx, testDenom, types.Attestation{
+		Reserves:  sdk.NewInt64Coin(testDenom, 100),
+		Timestamp: ctx.BlockTime(),
+	})
+
+	_, err = server.Mint(goCtx, types.NewMsgMint(minter, receiver, sdk.NewInt64Coin(testDenom, 101)))
+	require.ErrorIs(t, err, types.ErrMint)
+
+	_, err = server.Mint(goCtx, types.NewMsgMint(minter, receiver, sdk.NewInt64Coin(testDenom, 100)))
+	require.NoError(t, err)
+}
@@ -13,7 +13,7 @@ import (
 func (k msgServer) Blacklist(goCtx context.Context, msg *types.MsgBlacklist) (*types.MsgBlacklistResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	blacklister, found := k.GetBlacklister(ctx)
+	blacklister, found := k.GetBlacklister(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "blacklister is not set")
 	}
@@ -27,18 +27,23 @@ func (k msgServer) Blacklist(goCtx context.Context, msg *types.MsgBlacklist) (*t
 		return nil, err
 	}
 
-	_, found = k.GetBlacklisted(ctx, addressBz)
+	_, found = k.GetBlacklisted(ctx, msg.Denom, addressBz)
 	if found {
 		return nil, types.ErrUserBlacklisted
 	}
 
 	blacklisted := types.Blacklisted{
 		AddressBz: addressBz,
+		Height:    ctx.BlockHeight(),
 	}
 
-	k.SetBlacklisted(ctx, blacklisted)
+	k.SetBlacklisted(ctx, msg.Denom, blacklisted)
 
-	err = ctx.EventManager().EmitTypedEvent(msg)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventBlacklisted{
+		Denom:     msg.Denom,
+		Address:   msg.Address,
+		AddressBz: addressBz,
+	})
 
 	return &types.MsgBlacklistResponse{}, err
 }
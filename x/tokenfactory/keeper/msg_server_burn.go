@@ -16,7 +16,13 @@ func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBu
 }
 
 func (k Keeper) Burn(ctx sdk.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
-	_, found := k.GetMinters(ctx, msg.From)
+	denom := msg.Amount.Denom
+
+	if !k.HasMintingDenom(ctx, denom) {
+		return nil, sdkerrors.Wrap(types.ErrBurn, "burning denom is incorrect")
+	}
+
+	_, found := k.GetMinters(ctx, denom, msg.From)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrBurn, "%v: you are not a minter", types.ErrUnauthorized)
 	}
@@ -26,18 +32,12 @@ func (k Keeper) Burn(ctx sdk.Context, msg *types.MsgBurn) (*types.MsgBurnRespons
 		return nil, sdkerrors.Wrap(types.ErrBurn, err.Error())
 	}
 
-	_, found = k.GetBlacklisted(ctx, addressBz)
+	_, found = k.GetBlacklisted(ctx, denom, addressBz)
 	if found {
 		return nil, sdkerrors.Wrap(types.ErrBurn, "minter address is blacklisted")
 	}
 
-	mintingDenom := k.GetMintingDenom(ctx)
-
-	if msg.Amount.Denom != mintingDenom.Denom {
-		return nil, sdkerrors.Wrap(types.ErrBurn, "burning denom is incorrect")
-	}
-
-	paused := k.GetPaused(ctx)
+	paused := k.GetPaused(ctx, denom)
 
 	if paused.Paused {
 		return nil, sdkerrors.Wrap(types.ErrBurn, "burning is paused")
@@ -59,7 +59,10 @@ func (k Keeper) Burn(ctx sdk.Context, msg *types.MsgBurn) (*types.MsgBurnRespons
 		return nil, sdkerrors.Wrap(types.ErrBurn, err.Error())
 	}
 
-	err = ctx.EventManager().EmitTypedEvent(msg)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventBurned{
+		Minter: msg.From,
+		Amount: msg.Amount,
+	})
 
 	return &types.MsgBurnResponse{}, err
 }
@@ -12,35 +12,92 @@ import (
 func (k msgServer) ConfigureMinter(goCtx context.Context, msg *types.MsgConfigureMinter) (*types.MsgConfigureMinterResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	mintingDenom := k.GetMintingDenom(ctx)
+	denom := msg.Allowance.Denom
 
-	if msg.Allowance.Denom != mintingDenom.Denom {
+	if !k.HasMintingDenom(ctx, denom) {
 		return nil, sdkerrors.Wrapf(types.ErrMint, "minting denom is incorrect")
 	}
 
-	minterController, found := k.GetMinterController(ctx, msg.From)
-	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "minter controller not found")
+	minterController, err := k.checkMinterController(ctx, denom, msg.From, msg.Address)
+	if err != nil {
+		return nil, err
 	}
 
-	if msg.From != minterController.Controller {
-		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a controller of this minter")
+	event := types.EventMinterConfigured{
+		Controller: msg.From,
+		Minter:     msg.Address,
+		Allowance:  msg.Allowance,
+	}
+	previousAllowance := sdk.NewCoin(denom, sdk.ZeroInt())
+	if previous, found := k.GetMinters(ctx, denom, msg.Address); found {
+		event.PreviousAllowance = &previous.Allowance
+		previousAllowance = previous.Allowance
+	} else if err := k.checkMaxMinters(ctx, denom); err != nil {
+		return nil, err
 	}
 
-	if msg.Address != minterController.Minter {
-		return nil, sdkerrors.Wrapf(
-			types.ErrUnauthorized,
-			"minter address ≠ minter controller's minter address, (%s≠%s)",
-			msg.Address, minterController.Minter,
-		)
+	if err := k.grantAllowance(ctx, denom, minterController, previousAllowance, msg.Allowance); err != nil {
+		return nil, err
 	}
 
-	k.SetMinters(ctx, types.Minters{
+	k.SetMinters(ctx, denom, types.Minters{
 		Address:   msg.Address,
 		Allowance: msg.Allowance,
 	})
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	err = ctx.EventManager().EmitTypedEvent(&event)
 
 	return &types.MsgConfigureMinterResponse{}, err
 }
+
+// checkMinterController returns the MinterController of the from and minter
+// pair of denom, or ErrUnauthorized unless from is a controller of minter.
+func (k msgServer) checkMinterController(ctx sdk.Context, denom string, from string, minter string) (types.MinterController, error) {
+	minterController, found := k.GetMinterController(ctx, denom, from, minter)
+	if !found {
+		return types.MinterController{}, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a controller of this minter (%s)", minter)
+	}
+
+	return minterController, nil
+}
+
+// checkMaxMinters returns ErrMaxMinters if denom already has as many minters
+// as the MaxMinters param allows, if any.
+func (k Keeper) checkMaxMinters(ctx sdk.Context, denom string) error {
+	maxMinters := k.MaxMinters(ctx)
+	if maxMinters == 0 {
+		return nil
+	}
+	if uint64(len(k.GetAllMinters(ctx, denom))) >= maxMinters {
+		return sdkerrors.Wrapf(types.ErrMaxMinters, "%s already has %d minters", denom, maxMinters)
+	}
+	return nil
+}
+
+// grantAllowance records on minterController that the allowance of its minter
+// changes from previous to allowance. Raising the allowance adds to the
+// cumulative allowance granted by the controller, which must stay within its
+// cap, if any, while lowering it gives the unused allowance back.
+func (k msgServer) grantAllowance(ctx sdk.Context, denom string, minterController types.MinterController, previous sdk.Coin, allowance sdk.Coin) error {
+	granted := sdk.NewCoin(denom, sdk.ZeroInt())
+	if minterController.Granted != nil {
+		granted = *minterController.Granted
+	}
+
+	if previous.IsLT(allowance) {
+		granted = granted.Add(allowance.Sub(previous))
+		allowanceCap := minterController.AllowanceCap
+		if allowanceCap != nil && (allowanceCap.Denom != granted.Denom || allowanceCap.IsLT(granted)) {
+			return sdkerrors.Wrapf(types.ErrAllowanceCap, "cumulative allowance %s granted to %s exceeds the cap of %s", granted, minterController.Minter, allowanceCap)
+		}
+	} else if returned := previous.Sub(allowance); granted.IsLT(returned) {
+		granted = sdk.NewCoin(denom, sdk.ZeroInt())
+	} else {
+		granted = granted.Sub(returned)
+	}
+
+	minterController.Granted = &granted
+	k.SetMinterController(ctx, denom, minterController)
+
+	return nil
+}
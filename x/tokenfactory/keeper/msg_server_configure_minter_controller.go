@@ -12,7 +12,7 @@ import (
 func (k msgServer) ConfigureMinterController(goCtx context.Context, msg *types.MsgConfigureMinterController) (*types.MsgConfigureMinterControllerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	masterMinter, found := k.GetMasterMinter(ctx)
+	masterMinter, found := k.GetMasterMinter(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "master minter is not set")
 	}
@@ -21,12 +21,36 @@ func (k msgServer) ConfigureMinterController(goCtx context.Context, msg *types.M
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the master minter")
 	}
 
+	if msg.Controller == msg.Minter {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "a minter can not be its own controller")
+	}
+
+	if msg.AllowanceCap != nil && msg.AllowanceCap.Denom != msg.Denom {
+		return nil, sdkerrors.Wrapf(types.ErrMint, "allowance cap denom is incorrect")
+	}
+
+	previous, found := k.GetMinterController(ctx, msg.Denom, msg.Controller, msg.Minter)
+	if found && !msg.Force {
+		return nil, sdkerrors.Wrapf(types.ErrControllerExists, "controller %s already manages minter %s, force to overwrite", msg.Controller, msg.Minter)
+	}
+
 	controller := types.MinterController{
-		Minter:     msg.Minter,
-		Controller: msg.Controller,
+		Minter:       msg.Minter,
+		Controller:   msg.Controller,
+		AllowanceCap: msg.AllowanceCap,
+		Granted:      previous.Granted,
 	}
 
-	k.SetMinterController(ctx, controller)
+	k.SetMinterController(ctx, msg.Denom, controller)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMinterControllerConfigured{
+		Denom:                msg.Denom,
+		Controller:           msg.Controller,
+		Minter:               msg.Minter,
+		Overwritten:          found,
+		PreviousAllowanceCap: previous.AllowanceCap,
+		AllowanceCap:         msg.AllowanceCap,
+	})
 
-	return &types.MsgConfigureMinterControllerResponse{}, nil
+	return &types.MsgConfigureMinterControllerResponse{}, err
 }
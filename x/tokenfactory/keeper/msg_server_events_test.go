@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// lastEvent returns the last typed event emitted in ctx.
+func lastEvent(t *testing.T, ctx sdk.Context) proto.Message {
+	t.Helper()
+	events := ctx.EventManager().Events()
+	require.NotEmpty(t, events)
+	event, err := sdk.ParseTypedEvent(abci.Event(events[len(events)-1]))
+	require.NoError(t, err)
+	return event
+}
+
+func TestMsgServerEvents(t *testing.T) {
+	tf, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(tf)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	owner, masterMinter, controller, minter, pauser := sample.AccAddress(), sample.AccAddress(), sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	tf.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	tf.SetOwner(ctx, testDenom, types.Owner{Address: owner})
+
+	_, err := server.UpdateMasterMinter(goCtx, &types.MsgUpdateMasterMinter{From: owner, Denom: testDenom, Address: masterMinter})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMasterMinterUpdated{Denom: testDenom, MasterMinter: masterMinter}, lastEvent(t, ctx))
+
+	_, err = server.UpdatePauser(goCtx, &types.MsgUpdatePauser{From: owner, Denom: testDenom, Address: pauser})
+	require.NoError(t, err)
+	_, err = server.Unpause(goCtx, &types.MsgUnpause{From: pauser, Denom: testDenom})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventUnpaused{Denom: testDenom, Pauser: pauser}, lastEvent(t, ctx))
+
+	_, err = server.ConfigureMinterController(goCtx, &types.MsgConfigureMinterController{From: masterMinter, Denom: testDenom, Controller: controller, Minter: minter})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMinterControllerConfigured{Denom: testDenom, Controller: controller, Minter: minter}, lastEvent(t, ctx))
+
+	allowance := sdk.NewInt64Coin(testDenom, 10)
+	_, err = server.ConfigureMinter(goCtx, &types.MsgConfigureMinter{From: controller, Address: minter, Allowance: allowance})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMinterConfigured{Controller: controller, Minter: minter, Allowance: allowance}, lastEvent(t, ctx))
+
+	newAllowance := sdk.NewInt64Coin(testDenom, 20)
+	_, err = server.ConfigureMinter(goCtx, &types.MsgConfigureMinter{From: controller, Address: minter, Allowance: newAllowance})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMinterConfigured{
+		Controller:        controller,
+		Minter:            minter,
+		PreviousAllowance: &allowance,
+		Allowance:         newAllowance,
+	}, lastEvent(t, ctx))
+
+	recipient := sample.AccAddress()
+	amount := sdk.NewInt64Coin(testDenom, 5)
+	_, err = server.Mint(goCtx, &types.MsgMint{From: minter, Address: recipient, Amount: amount})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMinted{
+		Minter:            minter,
+		Recipient:         recipient,
+		Amount:            amount,
+		PreviousAllowance: newAllowance,
+		Allowance:         sdk.NewInt64Coin(testDenom, 15),
+	}, lastEvent(t, ctx))
+
+	_, err = server.RemoveMinter(goCtx, &types.MsgRemoveMinter{From: controller, Denom: testDenom, Address: minter})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMinterRemoved{
+		Controller: controller,
+		Minter:     minter,
+		Allowance:  sdk.NewInt64Coin(testDenom, 15),
+	}, lastEvent(t, ctx))
+
+	newOwner := sample.AccAddress()
+	_, err = server.UpdateOwner(goCtx, &types.MsgUpdateOwner{From: owner, Denom: testDenom, Address: newOwner})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventOwnershipTransferStarted{Denom: testDenom, Owner: owner, PendingOwner: newOwner}, lastEvent(t, ctx))
+	_, err = server.AcceptOwner(goCtx, &types.MsgAcceptOwner{From: newOwner, Denom: testDenom})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventOwnerUpdated{Denom: testDenom, PreviousOwner: owner, Owner: newOwner}, lastEvent(t, ctx))
+}
+
+func TestMintingDenomsAreIsolated(t *testing.T) {
+	tf, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(tf)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	owner, pauser, minter := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	for _, denom := range []string{"utoken", "uother"} {
+		tf.SetMintingDenom(ctx, types.MintingDenom{Denom: denom})
+		tf.SetPaused(ctx, denom, types.Paused{Paused: false})
+	}
+	tf.SetOwner(ctx, "utoken", types.Owner{Address: owner})
+	tf.SetOwner(ctx, "uother", types.Owner{Address: sample.AccAddress()})
+	tf.SetMinters(ctx, "utoken", types.Minters{Address: minter, Allowance: sdk.NewInt64Coin("utoken", 10)})
+
+	// the owner of a denom has no privilege over another
+	_, err := server.UpdatePauser(goCtx, &types.MsgUpdatePauser{From: owner, Denom: "uother", Address: pauser})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = server.UpdatePauser(goCtx, &types.MsgUpdatePauser{From: owner, Denom: "utoken", Address: pauser})
+	require.NoError(t, err)
+
+	_, err = server.Pause(goCtx, &types.MsgPause{From: pauser, Denom: "utoken"})
+	require.NoError(t, err)
+	require.True(t, tf.GetPaused(ctx, "utoken").Paused)
+	require.False(t, tf.GetPaused(ctx, "uother").Paused)
+
+	// a minter of a denom can not mint another
+	_, err = server.Mint(goCtx, &types.MsgMint{From: minter, Address: sample.AccAddress(), Amount: sdk.NewInt64Coin("uother", 1)})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// a denom that is not a minting denom is rejected
+	_, err = server.Mint(goCtx, &types.MsgMint{From: minter, Address: sample.AccAddress(), Amount: sdk.NewInt64Coin("uunknown", 1)})
+	require.ErrorIs(t, err, types.ErrMint)
+}
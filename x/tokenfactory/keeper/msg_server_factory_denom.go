@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (k msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	denom, err := types.GetFactoryDenom(msg.From, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetFactoryDenom(ctx, denom); found || k.HasMintingDenom(ctx, denom) {
+		return nil, sdkerrors.Wrapf(types.ErrDenomExists, "%s", denom)
+	}
+
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		return nil, sdkerrors.Wrapf(types.ErrDenomExists, "%s already has bank metadata", denom)
+	}
+
+	creator, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidDenom, err.Error())
+	}
+
+	fee := k.DenomCreationFee(ctx)
+	if !fee.IsZero() {
+		// the fee is paid through the raw bank keeper, so the restrictions of
+		// the minting denoms have to be enforced here.
+		for _, coin := range fee {
+			if !k.HasMintingDenom(ctx, coin.Denom) {
+				continue
+			}
+			if _, found := k.GetBlacklisted(ctx, coin.Denom, creator); found {
+				return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "creator address is blacklisted for %s", coin.Denom)
+			}
+			if k.GetPaused(ctx, coin.Denom).Paused {
+				return nil, sdkerrors.Wrapf(types.ErrPaused, "can not pay the creation fee in %s", coin.Denom)
+			}
+		}
+
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, authtypes.FeeCollectorName, fee); err != nil {
+			return nil, err
+		}
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Description: "",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+		},
+		Base:    denom,
+		Display: denom,
+		Name:    denom,
+		Symbol:  msg.Subdenom,
+	})
+
+	k.SetFactoryDenom(ctx, types.FactoryDenom{
+		Denom: denom,
+		Admin: msg.From,
+	})
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventFactoryDenomCreated{
+		Denom:   denom,
+		Creator: msg.From,
+		Fee:     fee,
+	})
+
+	return &types.MsgCreateDenomResponse{Denom: denom}, err
+}
+
+func (k msgServer) MintFactoryDenom(goCtx context.Context, msg *types.MsgMintFactoryDenom) (*types.MsgMintFactoryDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkFactoryDenomAdmin(ctx, msg.Amount.Denom, msg.From); err != nil {
+		return nil, err
+	}
+
+	recipient := msg.Address
+	if recipient == "" {
+		recipient = msg.From
+	}
+
+	recipientAddress, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrMint, err.Error())
+	}
+
+	amount := sdk.NewCoins(msg.Amount)
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, amount); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrMint, err.Error())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddress, amount); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrSendCoinsToAccount, err.Error())
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventFactoryDenomMinted{
+		Admin:     msg.From,
+		Recipient: recipient,
+		Amount:    msg.Amount,
+	})
+
+	return &types.MsgMintFactoryDenomResponse{}, err
+}
+
+func (k msgServer) BurnFactoryDenom(goCtx context.Context, msg *types.MsgBurnFactoryDenom) (*types.MsgBurnFactoryDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkFactoryDenomAdmin(ctx, msg.Amount.Denom, msg.From); err != nil {
+		return nil, err
+	}
+
+	adminAddress, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrBurn, err.Error())
+	}
+
+	amount := sdk.NewCoins(msg.Amount)
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, adminAddress, types.ModuleName, amount); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrBurn, err.Error())
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, amount); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrBurn, err.Error())
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventFactoryDenomBurned{
+		Admin:  msg.From,
+		Amount: msg.Amount,
+	})
+
+	return &types.MsgBurnFactoryDenomResponse{}, err
+}
+
+func (k msgServer) ChangeFactoryDenomAdmin(goCtx context.Context, msg *types.MsgChangeFactoryDenomAdmin) (*types.MsgChangeFactoryDenomAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkFactoryDenomAdmin(ctx, msg.Denom, msg.From); err != nil {
+		return nil, err
+	}
+
+	k.SetFactoryDenom(ctx, types.FactoryDenom{
+		Denom: msg.Denom,
+		Admin: msg.Admin,
+	})
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventFactoryDenomAdminChanged{
+		Denom:         msg.Denom,
+		PreviousAdmin: msg.From,
+		Admin:         msg.Admin,
+	})
+
+	return &types.MsgChangeFactoryDenomAdminResponse{}, err
+}
+
+// checkFactoryDenomAdmin returns an error unless denom is a factory denom
+// administered by address.
+func (k Keeper) checkFactoryDenomAdmin(ctx sdk.Context, denom string, address string) error {
+	factoryDenom, found := k.GetFactoryDenom(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidDenom, "%s does not exist", denom)
+	}
+
+	if factoryDenom.Admin == "" || factoryDenom.Admin != address {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the admin of %s", denom)
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestFactoryDenom(t *testing.T) {
+	bankKeeper := keepertest.NewMockMetadataBankKeeper()
+	tf, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, bankKeeper)
+	server := keeper.NewMsgServerImpl(tf)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	params := types.DefaultParams()
+	params.DenomCreationFee = sdk.NewCoins(sdk.NewInt64Coin("ustake", 100))
+	tf.SetParams(ctx, params)
+
+	creator, recipient, admin := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+
+	res, err := server.CreateDenom(goCtx, types.NewMsgCreateDenom(creator, "ufoo"))
+	require.NoError(t, err)
+	denom := "factory/" + creator + "/ufoo"
+	require.Equal(t, denom, res.Denom)
+	require.Equal(t, &types.EventFactoryDenomCreated{
+		Denom:   denom,
+		Creator: creator,
+		Fee:     params.DenomCreationFee,
+	}, lastEvent(t, ctx))
+
+	_, err = server.CreateDenom(goCtx, types.NewMsgCreateDenom(creator, "ufoo"))
+	require.ErrorIs(t, err, types.ErrDenomExists)
+
+	// the bank metadata of an existing denom is never overwritten
+	metadata := banktypes.Metadata{Base: "factory/" + creator + "/uqux", Name: "Qux"}
+	bankKeeper.SetDenomMetaData(ctx, metadata)
+	_, err = server.CreateDenom(goCtx, types.NewMsgCreateDenom(creator, "uqux"))
+	require.ErrorIs(t, err, types.ErrDenomExists)
+	got, _ := bankKeeper.GetDenomMetaData(ctx, metadata.Base)
+	require.Equal(t, metadata, got)
+
+	// the creator is the admin
+	_, err = server.MintFactoryDenom(goCtx, types.NewMsgMintFactoryDenom(recipient, "", sdk.NewInt64Coin(denom, 10)))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = server.MintFactoryDenom(goCtx, types.NewMsgMintFactoryDenom(creator, recipient, sdk.NewInt64Coin(denom, 10)))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventFactoryDenomMinted{
+		Admin:     creator,
+		Recipient: recipient,
+		Amount:    sdk.NewInt64Coin(denom, 10),
+	}, lastEvent(t, ctx))
+
+	_, err = server.MintFactoryDenom(goCtx, types.NewMsgMintFactoryDenom(creator, "", sdk.NewInt64Coin("factory/"+creator+"/ubar", 10)))
+	require.ErrorIs(t, err, types.ErrInvalidDenom)
+
+	_, err = server.BurnFactoryDenom(goCtx, types.NewMsgBurnFactoryDenom(creator, sdk.NewInt64Coin(denom, 5)))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventFactoryDenomBurned{
+		Admin:  creator,
+		Amount: sdk.NewInt64Coin(denom, 5),
+	}, lastEvent(t, ctx))
+
+	_, err = server.ChangeFactoryDenomAdmin(goCtx, types.NewMsgChangeFactoryDenomAdmin(creator, denom, admin))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventFactoryDenomAdminChanged{
+		Denom:         denom,
+		PreviousAdmin: creator,
+		Admin:         admin,
+	}, lastEvent(t, ctx))
+
+	_, err = server.BurnFactoryDenom(goCtx, types.NewMsgBurnFactoryDenom(creator, sdk.NewInt64Coin(denom, 5)))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// nobody administers the denom once the admin renounces
+	_, err = server.ChangeFactoryDenomAdmin(goCtx, types.NewMsgChangeFactoryDenomAdmin(admin, denom, ""))
+	require.NoError(t, err)
+	_, err = server.MintFactoryDenom(goCtx, types.NewMsgMintFactoryDenom(admin, "", sdk.NewInt64Coin(denom, 10)))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.CreateDenom(goCtx, types.NewMsgCreateDenom(creator, "ubar"))
+	require.NoError(t, err)
+	_, err = server.CreateDenom(goCtx, types.NewMsgCreateDenom(recipient, "ufoo"))
+	require.NoError(t, err)
+
+	queryRes, err := tf.FactoryDenomsByCreator(goCtx, &types.QueryFactoryDenomsByCreatorRequest{
+		Creator:    creator,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), queryRes.Pagination.Total)
+	require.Equal(t, []types.FactoryDenom{
+		{Denom: "factory/" + creator + "/ubar", Admin: creator},
+		{Denom: denom},
+	}, queryRes.FactoryDenoms)
+}
+
+func TestFactoryDenomCreationFeeRestrictions(t *testing.T) {
+	bankKeeper := keepertest.NewMockMetadataBankKeeper()
+	tf, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, bankKeeper)
+	server := keeper.NewMsgServerImpl(tf)
+	goCtx := sdk.WrapSDKContext(ctx)
+	bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{Base: testDenom})
+
+	params := types.DefaultParams()
+	params.DenomCreationFee = sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100))
+	tf.SetParams(ctx, params)
+	tf.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	tf.SetPaused(ctx, testDenom, types.Paused{Paused: false})
+
+	creator := sample.AccAddress()
+	creatorAddress, err := sdk.AccAddressFromBech32(creator)
+	require.NoError(t, err)
+
+	tf.SetBlacklisted(ctx, testDenom, types.Blacklisted{AddressBz: creatorAddress})
+	_, err = server.CreateDenom(goCtx, types.NewMsgCreateDenom(creator, "ufoo"))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	tf.RemoveBlacklisted(ctx, testDenom, creatorAddress)
+	tf.SetPaused(ctx, testDenom, types.Paused{Paused: true})
+	_, err = server.CreateDenom(goCtx, types.NewMsgCreateDenom(creator, "ufoo"))
+	require.ErrorIs(t, err, types.ErrPaused)
+}
@@ -16,65 +16,141 @@ func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMi
 	return k.Keeper.Mint(ctx, msg)
 }
 
+// Mint mints msg.Amount to msg.Address on behalf of the minter msg.From. A
+// mint above the approval threshold of its denom is left pending instead,
+// until a minter controller of the minter approves it. The allowance of a
+// pending mint is reserved right away, and given back to the minter if the
+// mint is rejected or expires.
 func (k Keeper) Mint(ctx sdk.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
-	minter, found := k.GetMinters(ctx, msg.From)
+	minter, err := k.checkMint(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if minter.Allowance.IsLT(msg.Amount) {
+		return nil, sdkerrors.Wrapf(types.ErrMint, "minting amount is greater than the allowance")
+	}
+
+	threshold, found := k.MintApprovalThreshold(ctx, msg.Amount.Denom)
+	if found && threshold.IsLT(msg.Amount) {
+		minter.Allowance = minter.Allowance.Sub(msg.Amount)
+		k.SetMinters(ctx, msg.Amount.Denom, minter)
+
+		expiresAt := ctx.BlockTime().Add(k.MintApprovalWindow(ctx))
+		id := k.AppendPendingMint(ctx, msg.Amount.Denom, types.PendingMint{
+			Minter:    msg.From,
+			Address:   msg.Address,
+			Amount:    msg.Amount,
+			ExpiresAt: expiresAt,
+		})
+
+		err := ctx.EventManager().EmitTypedEvent(&types.EventMintRequested{
+			Id:        id,
+			Minter:    msg.From,
+			Recipient: msg.Address,
+			Amount:    msg.Amount,
+			ExpiresAt: expiresAt,
+		})
+
+		return &types.MsgMintResponse{Pending: true, PendingMintId: id}, err
+	}
+
+	if err := k.mint(ctx, minter, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintResponse{}, nil
+}
+
+// checkMint returns the minter of msg if it is allowed to mint msg.Amount to
+// msg.Address right now, regardless of its allowance.
+func (k Keeper) checkMint(ctx sdk.Context, msg *types.MsgMint) (types.Minters, error) {
+	denom := msg.Amount.Denom
+
+	if !k.HasMintingDenom(ctx, denom) {
+		return types.Minters{}, sdkerrors.Wrapf(types.ErrMint, "minting denom is incorrect")
+	}
+
+	minter, found := k.GetMinters(ctx, denom, msg.From)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a minter")
+		return types.Minters{}, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a minter")
 	}
 
 	_, addressBz, err := bech32.DecodeAndConvert(msg.From)
 	if err != nil {
-		return nil, err
+		return types.Minters{}, err
 	}
 
-	_, found = k.GetBlacklisted(ctx, addressBz)
+	_, found = k.GetBlacklisted(ctx, denom, addressBz)
 	if found {
-		return nil, sdkerrors.Wrapf(types.ErrMint, "minter address is blacklisted")
+		return types.Minters{}, sdkerrors.Wrapf(types.ErrMint, "minter address is blacklisted")
 	}
 
 	_, addressBz, err = bech32.DecodeAndConvert(msg.Address)
 	if err != nil {
-		return nil, err
+		return types.Minters{}, err
 	}
 
-	_, found = k.GetBlacklisted(ctx, addressBz)
+	_, found = k.GetBlacklisted(ctx, denom, addressBz)
 	if found {
-		return nil, sdkerrors.Wrapf(types.ErrMint, "receiver address is blacklisted")
+		return types.Minters{}, sdkerrors.Wrapf(types.ErrMint, "receiver address is blacklisted")
 	}
 
-	mintingDenom := k.GetMintingDenom(ctx)
+	paused := k.GetPaused(ctx, denom)
 
-	if msg.Amount.Denom != mintingDenom.Denom {
-		return nil, sdkerrors.Wrapf(types.ErrMint, "minting denom is incorrect")
+	if paused.Paused {
+		return types.Minters{}, sdkerrors.Wrapf(types.ErrMint, "minting is paused")
 	}
 
-	if minter.Allowance.IsLT(msg.Amount) {
-		return nil, sdkerrors.Wrapf(types.ErrMint, "minting amount is greater than the allowance")
+	if maxMintAmount, found := k.MaxMintAmount(ctx, denom); found && maxMintAmount.IsLT(msg.Amount) {
+		return types.Minters{}, sdkerrors.Wrapf(types.ErrMint, "minting amount is greater than the max mint amount of %s", maxMintAmount)
 	}
 
-	paused := k.GetPaused(ctx)
+	if supplyCap, found := k.SupplyCap(ctx, denom); found {
+		supply := k.bankKeeper.GetSupply(ctx, denom)
+		if supplyCap.IsLT(supply.Add(msg.Amount)) {
+			return types.Minters{}, sdkerrors.Wrapf(types.ErrMint, "minting %s would exceed the supply cap of %s", msg.Amount, supplyCap)
+		}
+	}
 
-	if paused.Paused {
-		return nil, sdkerrors.Wrapf(types.ErrMint, "minting is paused")
+	if err := k.checkReserves(ctx, msg.Amount); err != nil {
+		return types.Minters{}, err
 	}
 
+	return minter, nil
+}
+
+// mint mints msg.Amount to msg.Address and charges it on the allowance of
+// minter.
+func (k Keeper) mint(ctx sdk.Context, minter types.Minters, msg *types.MsgMint) error {
+	previousAllowance := minter.Allowance
 	minter.Allowance = minter.Allowance.Sub(msg.Amount)
 
-	k.SetMinters(ctx, minter)
+	k.SetMinters(ctx, msg.Amount.Denom, minter)
 
+	return k.issue(ctx, msg, previousAllowance, minter.Allowance)
+}
+
+// issue mints msg.Amount to msg.Address, once it has been charged on the
+// allowance of the minter.
+func (k Keeper) issue(ctx sdk.Context, msg *types.MsgMint, previousAllowance sdk.Coin, allowance sdk.Coin) error {
 	amount := sdk.NewCoins(msg.Amount)
 
 	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, amount); err != nil {
-		return nil, sdkerrors.Wrap(types.ErrMint, err.Error())
+		return sdkerrors.Wrap(types.ErrMint, err.Error())
 	}
 
 	receiver, _ := sdk.AccAddressFromBech32(msg.Address)
 
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, amount); err != nil {
-		return nil, sdkerrors.Wrap(types.ErrSendCoinsToAccount, err.Error())
+		return sdkerrors.Wrap(types.ErrSendCoinsToAccount, err.Error())
 	}
 
-	err = ctx.EventManager().EmitTypedEvent(msg)
-
-	return &types.MsgMintResponse{}, err
+	return ctx.EventManager().EmitTypedEvent(&types.EventMinted{
+		Minter:            msg.From,
+		Recipient:         msg.Address,
+		Amount:            msg.Amount,
+		PreviousAllowance: previousAllowance,
+		Allowance:         allowance,
+	})
 }
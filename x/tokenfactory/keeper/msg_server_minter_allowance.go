@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) IncreaseMinterAllowance(goCtx context.Context, msg *types.MsgIncreaseMinterAllowance) (*types.MsgIncreaseMinterAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	minterController, previousAllowance, err := k.currentMinterAllowance(ctx, msg.From, msg.Address, msg.Amount, msg.ExpectedAllowance, true)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance := previousAllowance.Add(msg.Amount)
+
+	if err := k.grantAllowance(ctx, msg.Amount.Denom, minterController, previousAllowance, allowance); err != nil {
+		return nil, err
+	}
+
+	k.SetMinters(ctx, msg.Amount.Denom, types.Minters{
+		Address:   msg.Address,
+		Allowance: allowance,
+	})
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMinterAllowanceIncreased{
+		Controller:        msg.From,
+		Minter:            msg.Address,
+		Amount:            msg.Amount,
+		PreviousAllowance: previousAllowance,
+		Allowance:         allowance,
+	})
+
+	return &types.MsgIncreaseMinterAllowanceResponse{Allowance: allowance}, err
+}
+
+func (k msgServer) DecreaseMinterAllowance(goCtx context.Context, msg *types.MsgDecreaseMinterAllowance) (*types.MsgDecreaseMinterAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	minterController, previousAllowance, err := k.currentMinterAllowance(ctx, msg.From, msg.Address, msg.Amount, msg.ExpectedAllowance, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if previousAllowance.IsLT(msg.Amount) {
+		return nil, sdkerrors.Wrapf(types.ErrMint, "can not decrease the allowance of %s by %s", previousAllowance, msg.Amount)
+	}
+
+	allowance := previousAllowance.Sub(msg.Amount)
+
+	if err := k.grantAllowance(ctx, msg.Amount.Denom, minterController, previousAllowance, allowance); err != nil {
+		return nil, err
+	}
+
+	k.SetMinters(ctx, msg.Amount.Denom, types.Minters{
+		Address:   msg.Address,
+		Allowance: allowance,
+	})
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMinterAllowanceDecreased{
+		Controller:        msg.From,
+		Minter:            msg.Address,
+		Amount:            msg.Amount,
+		PreviousAllowance: previousAllowance,
+		Allowance:         allowance,
+	})
+
+	return &types.MsgDecreaseMinterAllowanceResponse{Allowance: allowance}, err
+}
+
+// currentMinterAllowance authorizes from as a controller of minter and
+// returns their MinterController and the current allowance of minter, after
+// checking it against the expected one, if any. A minter that is not configured yet has a zero
+// allowance if allowNew is set, and is not found otherwise.
+func (k msgServer) currentMinterAllowance(ctx sdk.Context, from string, minter string, amount sdk.Coin, expected *sdk.Coin, allowNew bool) (types.MinterController, sdk.Coin, error) {
+	denom := amount.Denom
+
+	if !k.HasMintingDenom(ctx, denom) {
+		return types.MinterController{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrMint, "minting denom is incorrect")
+	}
+
+	minterController, err := k.checkMinterController(ctx, denom, from, minter)
+	if err != nil {
+		return types.MinterController{}, sdk.Coin{}, err
+	}
+
+	allowance := sdk.NewCoin(denom, sdk.ZeroInt())
+	current, found := k.GetMinters(ctx, denom, minter)
+	switch {
+	case found:
+		allowance = current.Allowance
+	case !allowNew:
+		return types.MinterController{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrUserNotFound, "a minter with a given address doesn't exist")
+	default:
+		if err := k.checkMaxMinters(ctx, denom); err != nil {
+			return types.MinterController{}, sdk.Coin{}, err
+		}
+	}
+
+	if expected != nil && (expected.Denom != allowance.Denom || !expected.Amount.Equal(allowance.Amount)) {
+		return types.MinterController{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrAllowanceMismatch, "expected %s, got %s", expected, allowance)
+	}
+
+	return minterController, allowance, nil
+}
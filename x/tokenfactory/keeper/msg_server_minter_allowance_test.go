@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestMinterAllowance(t *testing.T) {
+	tf, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(tf)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	controller, minter := sample.AccAddress(), sample.AccAddress()
+	tf.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	tf.SetMinterController(ctx, testDenom, types.MinterController{Controller: controller, Minter: minter})
+	coin := func(amount int64) sdk.Coin { return sdk.NewInt64Coin(testDenom, amount) }
+	coinPtr := func(amount int64) *sdk.Coin { c := coin(amount); return &c }
+
+	// only the controller of the minter is authorized
+	_, err := server.IncreaseMinterAllowance(goCtx, types.NewMsgIncreaseMinterAllowance(sample.AccAddress(), minter, coin(1), nil))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = server.IncreaseMinterAllowance(goCtx, types.NewMsgIncreaseMinterAllowance(controller, sample.AccAddress(), coin(1), nil))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// decreasing the allowance of a minter that is not configured yet fails
+	_, err = server.DecreaseMinterAllowance(goCtx, types.NewMsgDecreaseMinterAllowance(controller, minter, coin(1), nil))
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+
+	res, err := server.IncreaseMinterAllowance(goCtx, types.NewMsgIncreaseMinterAllowance(controller, minter, coin(10), coinPtr(0)))
+	require.NoError(t, err)
+	require.Equal(t, coin(10), res.Allowance)
+
+	res, err = server.IncreaseMinterAllowance(goCtx, types.NewMsgIncreaseMinterAllowance(controller, minter, coin(5), nil))
+	require.NoError(t, err)
+	require.Equal(t, coin(15), res.Allowance)
+
+	// the minter minted in between
+	tf.SetMinters(ctx, testDenom, types.Minters{Address: minter, Allowance: coin(12)})
+	_, err = server.IncreaseMinterAllowance(goCtx, types.NewMsgIncreaseMinterAllowance(controller, minter, coin(5), coinPtr(15)))
+	require.ErrorIs(t, err, types.ErrAllowanceMismatch)
+
+	_, err = server.DecreaseMinterAllowance(goCtx, types.NewMsgDecreaseMinterAllowance(controller, minter, coin(13), nil))
+	require.ErrorIs(t, err, types.ErrMint)
+
+	decreaseRes, err := server.DecreaseMinterAllowance(goCtx, types.NewMsgDecreaseMinterAllowance(controller, minter, coin(2), coinPtr(12)))
+	require.NoError(t, err)
+	require.Equal(t, coin(10), decreaseRes.Allowance)
+	require.Equal(t, &types.EventMinterAllowanceDecreased{
+		Controller:        controller,
+		Minter:            minter,
+		Amount:            coin(2),
+		PreviousAllowance: coin(12),
+		Allowance:         coin(10),
+	}, lastEvent(t, ctx))
+
+	_, err = server.IncreaseMinterAllowance(goCtx, types.NewMsgIncreaseMinterAllowance(controller, minter, sdk.NewInt64Coin("uother", 1), nil))
+	require.ErrorIs(t, err, types.ErrMint)
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestMinterControllerCaps(t *testing.T) {
+	tf, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(tf)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	masterMinter, controller, minter1, minter2 := sample.AccAddress(), sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	tf.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	tf.SetMasterMinter(ctx, testDenom, types.MasterMinter{Address: masterMinter})
+	coin := func(amount int64) sdk.Coin { return sdk.NewInt64Coin(testDenom, amount) }
+	coinPtr := func(amount int64) *sdk.Coin { c := coin(amount); return &c }
+
+	// a controller manages several minters, each with its own cap
+	_, err := server.ConfigureMinterController(goCtx, types.NewMsgConfigureMinterController(masterMinter, testDenom, controller, minter1, coinPtr(10), false))
+	require.NoError(t, err)
+	_, err = server.ConfigureMinterController(goCtx, types.NewMsgConfigureMinterController(masterMinter, testDenom, controller, minter2, nil, false))
+	require.NoError(t, err)
+	require.Len(t, tf.GetMinterControllersByController(ctx, testDenom, controller), 2)
+
+	// the cap must be in the minting denom
+	_, err = server.ConfigureMinterController(goCtx, types.NewMsgConfigureMinterController(masterMinter, testDenom, sample.AccAddress(), minter1, &sdk.Coin{Denom: "other", Amount: sdk.NewInt(1)}, false))
+	require.ErrorIs(t, err, types.ErrMint)
+
+	// a minter can not control itself
+	_, err = server.ConfigureMinterController(goCtx, types.NewMsgConfigureMinterController(masterMinter, testDenom, minter1, minter1, nil, false))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// overwriting a pair must be forced
+	_, err = server.ConfigureMinterController(goCtx, types.NewMsgConfigureMinterController(masterMinter, testDenom, controller, minter1, coinPtr(20), false))
+	require.ErrorIs(t, err, types.ErrControllerExists)
+
+	// the cap bounds the allowances granted by the controller
+	_, err = server.ConfigureMinter(goCtx, &types.MsgConfigureMinter{From: controller, Address: minter1, Allowance: coin(11)})
+	require.ErrorIs(t, err, types.ErrAllowanceCap)
+	_, err = server.ConfigureMinter(goCtx, &types.MsgConfigureMinter{From: controller, Address: minter1, Allowance: coin(10)})
+	require.NoError(t, err)
+	_, err = server.IncreaseMinterAllowance(goCtx, types.NewMsgIncreaseMinterAllowance(controller, minter1, coin(1), nil))
+	require.ErrorIs(t, err, types.ErrAllowanceCap)
+	_, err = server.ConfigureMinter(goCtx, &types.MsgConfigureMinter{From: controller, Address: minter2, Allowance: coin(100)})
+	require.NoError(t, err)
+
+	_, err = server.ConfigureMinterController(goCtx, types.NewMsgConfigureMinterController(masterMinter, testDenom, controller, minter1, coinPtr(20), true))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMinterControllerConfigured{
+		Denom:                testDenom,
+		Controller:           controller,
+		Minter:               minter1,
+		Overwritten:          true,
+		PreviousAllowanceCap: coinPtr(10),
+		AllowanceCap:         coinPtr(20),
+	}, lastEvent(t, ctx))
+	_, err = server.IncreaseMinterAllowance(goCtx, types.NewMsgIncreaseMinterAllowance(controller, minter1, coin(10), nil))
+	require.NoError(t, err)
+	got, found := tf.GetMinterController(ctx, testDenom, controller, minter1)
+	require.True(t, found)
+	require.Equal(t, coinPtr(20), got.Granted)
+
+	// the cap is cumulative: unused allowance taken back can be granted again,
+	// allowance spent by the minter can not
+	_, err = server.DecreaseMinterAllowance(goCtx, types.NewMsgDecreaseMinterAllowance(controller, minter1, coin(5), nil))
+	require.NoError(t, err)
+	_, err = server.IncreaseMinterAllowance(goCtx, types.NewMsgIncreaseMinterAllowance(controller, minter1, coin(5), nil))
+	require.NoError(t, err)
+	tf.SetMinters(ctx, testDenom, types.Minters{Address: minter1, Allowance: coin(0)})
+	_, err = server.ConfigureMinter(goCtx, &types.MsgConfigureMinter{From: controller, Address: minter1, Allowance: coin(1)})
+	require.ErrorIs(t, err, types.ErrAllowanceCap)
+
+	// removing a minter gives its remaining allowance back to its controller
+	_, err = server.DecreaseMinterAllowance(goCtx, types.NewMsgDecreaseMinterAllowance(controller, minter2, coin(40), nil))
+	require.NoError(t, err)
+	_, err = server.RemoveMinter(goCtx, types.NewMsgRemoveMinter(controller, testDenom, minter2))
+	require.NoError(t, err)
+	got, found = tf.GetMinterController(ctx, testDenom, controller, minter2)
+	require.True(t, found)
+	require.Equal(t, coinPtr(0), got.Granted)
+
+	// removing a single pair keeps the others
+	_, err = server.RemoveMinterController(goCtx, types.NewMsgRemoveMinterController(masterMinter, testDenom, controller, minter1))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMinterControllerRemoved{Denom: testDenom, Controller: controller, Minter: minter1}, lastEvent(t, ctx))
+	_, err = server.ConfigureMinter(goCtx, &types.MsgConfigureMinter{From: controller, Address: minter1, Allowance: coin(1)})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.Empty(t, tf.GetMinterControllersByMinter(ctx, testDenom, minter1))
+
+	// removing without a minter removes all the pairs of the controller
+	_, err = server.RemoveMinterController(goCtx, types.NewMsgRemoveMinterController(masterMinter, testDenom, controller, ""))
+	require.NoError(t, err)
+	require.Empty(t, tf.GetMinterControllersByController(ctx, testDenom, controller))
+	_, err = server.RemoveMinterController(goCtx, types.NewMsgRemoveMinterController(masterMinter, testDenom, controller, ""))
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+}
@@ -12,7 +12,7 @@ import (
 func (k msgServer) Pause(goCtx context.Context, msg *types.MsgPause) (*types.MsgPauseResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	pauser, found := k.GetPauser(ctx)
+	pauser, found := k.GetPauser(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "pauser is not set")
 	}
@@ -21,13 +21,19 @@ func (k msgServer) Pause(goCtx context.Context, msg *types.MsgPause) (*types.Msg
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the pauser")
 	}
 
+	previousPaused := k.PausedSet(ctx, msg.Denom) && k.GetPaused(ctx, msg.Denom).Paused
+
 	paused := types.Paused{
 		Paused: true,
 	}
 
-	k.SetPaused(ctx, paused)
+	k.SetPaused(ctx, msg.Denom, paused)
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventPaused{
+		Denom:          msg.Denom,
+		Pauser:         msg.From,
+		PreviousPaused: previousPaused,
+	})
 
 	return &types.MsgPauseResponse{}, err
 }
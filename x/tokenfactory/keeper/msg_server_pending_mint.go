@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) ApproveMint(goCtx context.Context, msg *types.MsgApproveMint) (*types.MsgApproveMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pendingMint, found := k.GetPendingMint(ctx, msg.Denom, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrPendingMintUnknown, "pending mint %d of %s", msg.Id, msg.Denom)
+	}
+
+	if msg.From == pendingMint.Minter {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "a minter can not approve its own mint")
+	}
+
+	if _, found := k.GetMinterController(ctx, msg.Denom, msg.From, pendingMint.Minter); !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a controller of the minter %s", pendingMint.Minter)
+	}
+
+	if ctx.BlockTime().After(pendingMint.ExpiresAt) {
+		return nil, sdkerrors.Wrapf(types.ErrPendingMintExpired, "pending mint %d expired at %s", msg.Id, pendingMint.ExpiresAt)
+	}
+
+	// the state may have changed since the mint was requested, so it is
+	// checked again as a whole, except for the allowance it already reserved
+	mintMsg := types.NewMsgMint(pendingMint.Minter, pendingMint.Address, pendingMint.Amount)
+	minter, err := k.checkMint(ctx, mintMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	k.RemovePendingMint(ctx, msg.Denom, msg.Id)
+
+	if err := k.issue(ctx, mintMsg, minter.Allowance, minter.Allowance); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMintApproved{
+		Id:       msg.Id,
+		Approver: msg.From,
+		Minter:   pendingMint.Minter,
+		Denom:    msg.Denom,
+	})
+
+	return &types.MsgApproveMintResponse{}, err
+}
+
+func (k msgServer) RejectMint(goCtx context.Context, msg *types.MsgRejectMint) (*types.MsgRejectMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pendingMint, found := k.GetPendingMint(ctx, msg.Denom, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrPendingMintUnknown, "pending mint %d of %s", msg.Id, msg.Denom)
+	}
+
+	if msg.From != pendingMint.Minter {
+		if _, found := k.GetMinterController(ctx, msg.Denom, msg.From, pendingMint.Minter); !found {
+			return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are neither the minter nor one of its controllers")
+		}
+	}
+
+	k.RemovePendingMint(ctx, msg.Denom, msg.Id)
+	k.releasePendingMintAllowance(ctx, msg.Denom, pendingMint)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMintRejected{
+		Id:       msg.Id,
+		Rejector: msg.From,
+		Minter:   pendingMint.Minter,
+		Denom:    msg.Denom,
+	})
+
+	return &types.MsgRejectMintResponse{}, err
+}
@@ -0,0 +1,220 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func setupMintApproval(t *testing.T) (*keeper.Keeper, sdk.Context, string, string) {
+	tf, ctx := keepertest.TokenfactoryKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+
+	minter := sample.AccAddress()
+	controller := sample.AccAddress()
+	tf.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	tf.SetPaused(ctx, testDenom, types.Paused{Paused: false})
+	tf.SetMinters(ctx, testDenom, types.Minters{Address: minter, Allowance: sdk.NewInt64Coin(testDenom, 1000)})
+	tf.SetMinterController(ctx, testDenom, types.MinterController{Controller: controller, Minter: minter})
+
+	params := types.DefaultParams()
+	params.MintApprovalThresholds = sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100))
+	tf.SetParams(ctx, params)
+
+	return tf, ctx, minter, controller
+}
+
+func TestMintAboveApprovalThreshold(t *testing.T) {
+	tf, ctx, minter, controller := setupMintApproval(t)
+	server := keeper.NewMsgServerImpl(tf)
+	goCtx := sdk.WrapSDKContext(ctx)
+	receiver := sample.AccAddress()
+
+	res, err := server.Mint(goCtx, types.NewMsgMint(minter, receiver, sdk.NewInt64Coin(testDenom, 100)))
+	require.NoError(t, err)
+	require.False(t, res.Pending)
+
+	res, err = server.Mint(goCtx, types.NewMsgMint(minter, receiver, sdk.NewInt64Coin(testDenom, 101)))
+	require.NoError(t, err)
+	require.True(t, res.Pending)
+	require.Equal(t, uint64(0), res.PendingMintId)
+
+	expiresAt := ctx.BlockTime().Add(types.DefaultMintApprovalWindow)
+	require.Equal(t, &types.EventMintRequested{
+		Id:        0,
+		Minter:    minter,
+		Recipient: receiver,
+		Amount:    sdk.NewInt64Coin(testDenom, 101),
+		ExpiresAt: expiresAt,
+	}, lastEvent(t, ctx))
+
+	// the allowance is reserved as soon as the mint is requested
+	got, found := tf.GetMinters(ctx, testDenom, minter)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin(testDenom, 799), got.Allowance)
+
+	pendingRes, err := tf.PendingMint(goCtx, &types.QueryGetPendingMintRequest{Denom: testDenom, Id: 0})
+	require.NoError(t, err)
+	require.Equal(t, expiresAt, pendingRes.PendingMint.ExpiresAt)
+
+	_, err = server.ApproveMint(goCtx, types.NewMsgApproveMint(minter, testDenom, 0))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.ApproveMint(goCtx, types.NewMsgApproveMint(controller, testDenom, 1))
+	require.ErrorIs(t, err, types.ErrPendingMintUnknown)
+
+	_, err = server.ApproveMint(goCtx, types.NewMsgApproveMint(controller, testDenom, 0))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMintApproved{
+		Id:       0,
+		Approver: controller,
+		Minter:   minter,
+		Denom:    testDenom,
+	}, lastEvent(t, ctx))
+
+	got, found = tf.GetMinters(ctx, testDenom, minter)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin(testDenom, 799), got.Allowance)
+
+	_, found = tf.GetPendingMint(ctx, testDenom, 0)
+	require.False(t, found)
+}
+
+func TestPendingMintsReserveAllowance(t *testing.T) {
+	tf, ctx, minter, _ := setupMintApproval(t)
+	server := keeper.NewMsgServerImpl(tf)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	for i := 0; i < 2; i++ {
+		_, err := server.Mint(goCtx, types.NewMsgMint(minter, sample.AccAddress(), sdk.NewInt64Coin(testDenom, 400)))
+		require.NoError(t, err)
+	}
+
+	// the pending mints can not reserve more than the allowance
+	_, err := server.Mint(goCtx, types.NewMsgMint(minter, sample.AccAddress(), sdk.NewInt64Coin(testDenom, 201)))
+	require.ErrorIs(t, err, types.ErrMint)
+	require.Len(t, tf.GetAllPendingMints(ctx, testDenom), 2)
+
+	got, found := tf.GetMinters(ctx, testDenom, minter)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin(testDenom, 200), got.Allowance)
+}
+
+func TestApproveMintRechecksMint(t *testing.T) {
+	tf, ctx, minter, controller := setupMintApproval(t)
+	server := keeper.NewMsgServerImpl(tf)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, err := server.Mint(goCtx, types.NewMsgMint(minter, sample.AccAddress(), sdk.NewInt64Coin(testDenom, 101)))
+	require.NoError(t, err)
+
+	tf.SetPaused(ctx, testDenom, types.Paused{Paused: true})
+	_, err = server.ApproveMint(goCtx, types.NewMsgApproveMint(controller, testDenom, 0))
+	require.ErrorIs(t, err, types.ErrMint)
+
+	tf.SetPaused(ctx, testDenom, types.Paused{Paused: false})
+	expired := ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultMintApprovalWindow + time.Second))
+	_, err = server.ApproveMint(sdk.WrapSDKContext(expired), types.NewMsgApproveMint(controller, testDenom, 0))
+	require.ErrorIs(t, err, types.ErrPendingMintExpired)
+}
+
+func TestApproveMintRejectsSelfApproval(t *testing.T) {
+	tf, ctx, minter, _ := setupMintApproval(t)
+	server := keeper.NewMsgServerImpl(tf)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, err := server.Mint(goCtx, types.NewMsgMint(minter, sample.AccAddress(), sdk.NewInt64Coin(testDenom, 101)))
+	require.NoError(t, err)
+
+	// even a minter controlling itself can not approve its own mint
+	tf.SetMinterController(ctx, testDenom, types.MinterController{Controller: minter, Minter: minter})
+	_, err = server.ApproveMint(goCtx, types.NewMsgApproveMint(minter, testDenom, 0))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, found := tf.GetPendingMint(ctx, testDenom, 0)
+	require.True(t, found)
+}
+
+func TestRejectMint(t *testing.T) {
+	tf, ctx, minter, controller := setupMintApproval(t)
+	server := keeper.NewMsgServerImpl(tf)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	for i := 0; i < 2; i++ {
+		_, err := server.Mint(goCtx, types.NewMsgMint(minter, sample.AccAddress(), sdk.NewInt64Coin(testDenom, 101)))
+		require.NoError(t, err)
+	}
+
+	_, err := server.RejectMint(goCtx, types.NewMsgRejectMint(sample.AccAddress(), testDenom, 0))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.RejectMint(goCtx, types.NewMsgRejectMint(controller, testDenom, 0))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMintRejected{
+		Id:       0,
+		Rejector: controller,
+		Minter:   minter,
+		Denom:    testDenom,
+	}, lastEvent(t, ctx))
+
+	_, err = server.RejectMint(goCtx, types.NewMsgRejectMint(minter, testDenom, 1))
+	require.NoError(t, err)
+
+	_, err = server.RejectMint(goCtx, types.NewMsgRejectMint(minter, testDenom, 1))
+	require.ErrorIs(t, err, types.ErrPendingMintUnknown)
+
+	require.Empty(t, tf.GetAllPendingMints(ctx, testDenom))
+	require.Equal(t, uint64(2), tf.GetPendingMintCount(ctx, testDenom))
+
+	// the reserved allowance is given back
+	got, found := tf.GetMinters(ctx, testDenom, minter)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin(testDenom, 1000), got.Allowance)
+}
+
+func TestRemoveExpiredPendingMints(t *testing.T) {
+	tf, ctx, minter, _ := setupMintApproval(t)
+	server := keeper.NewMsgServerImpl(tf)
+
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	for _, c := range []sdk.Context{ctx, later, ctx} {
+		_, err := server.Mint(sdk.WrapSDKContext(c), types.NewMsgMint(minter, sample.AccAddress(), sdk.NewInt64Coin(testDenom, 101)))
+		require.NoError(t, err)
+	}
+
+	// a pending mint can still be approved at its expiry time
+	expiry := ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultMintApprovalWindow))
+	require.NoError(t, tf.RemoveExpiredPendingMints(expiry, types.MaxPendingMintExpiriesPerBlock))
+	require.Len(t, tf.GetAllPendingMints(ctx, testDenom), 3)
+
+	// at most limit pending mints are removed per call, the earliest expiring
+	// first
+	expired := ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultMintApprovalWindow + time.Second))
+	for _, id := range []uint64{0, 2} {
+		require.NoError(t, tf.RemoveExpiredPendingMints(expired, 1))
+		require.Equal(t, &types.EventMintExpired{
+			Id:     id,
+			Minter: minter,
+			Denom:  testDenom,
+		}, lastEvent(t, expired))
+		_, found := tf.GetPendingMint(ctx, testDenom, id)
+		require.False(t, found)
+	}
+
+	require.NoError(t, tf.RemoveExpiredPendingMints(expired, 1))
+	pendingMints := tf.GetAllPendingMints(ctx, testDenom)
+	require.Len(t, pendingMints, 1)
+	require.Equal(t, uint64(1), pendingMints[0].Id)
+
+	// only the allowance of the expired mints is given back
+	got, found := tf.GetMinters(ctx, testDenom, minter)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin(testDenom, 899), got.Allowance)
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestMintPolicyLimits(t *testing.T) {
+	tf, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(tf)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	minter := sample.AccAddress()
+	receiver := sample.AccAddress()
+	tf.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	tf.SetPaused(ctx, testDenom, types.Paused{Paused: false})
+	tf.SetMinters(ctx, testDenom, types.Minters{Address: minter, Allowance: sdk.NewInt64Coin(testDenom, 1000)})
+
+	params := types.DefaultParams()
+	params.MaxMintAmounts = sdk.NewCoins(sdk.NewInt64Coin(testDenom, 50), sdk.NewInt64Coin("uother", 1000))
+	tf.SetParams(ctx, params)
+
+	_, err := server.Mint(goCtx, types.NewMsgMint(minter, receiver, sdk.NewInt64Coin(testDenom, 51)))
+	require.ErrorIs(t, err, types.ErrMint)
+	_, err = server.Mint(goCtx, types.NewMsgMint(minter, receiver, sdk.NewInt64Coin(testDenom, 50)))
+	require.NoError(t, err)
+
+	// the supply of the mock bank keeper is always zero
+	params.MaxMintAmounts = nil
+	params.SupplyCaps = sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100))
+	tf.SetParams(ctx, params)
+
+	_, err = server.Mint(goCtx, types.NewMsgMint(minter, receiver, sdk.NewInt64Coin(testDenom, 101)))
+	require.ErrorIs(t, err, types.ErrMint)
+	_, err = server.Mint(goCtx, types.NewMsgMint(minter, receiver, sdk.NewInt64Coin(testDenom, 100)))
+	require.NoError(t, err)
+}
+
+func TestMaxMinters(t *testing.T) {
+	tf, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(tf)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	controller := sample.AccAddress()
+	minter, otherMinter := sample.AccAddress(), sample.AccAddress()
+	allowance := sdk.NewInt64Coin(testDenom, 10)
+	tf.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	tf.SetMinterController(ctx, testDenom, types.MinterController{Controller: controller, Minter: minter})
+	tf.SetMinterController(ctx, testDenom, types.MinterController{Controller: controller, Minter: otherMinter})
+
+	params := types.DefaultParams()
+	params.MaxMinters = 1
+	tf.SetParams(ctx, params)
+
+	_, err := server.ConfigureMinter(goCtx, types.NewMsgConfigureMinter(controller, minter, allowance))
+	require.NoError(t, err)
+
+	// a configured minter can still be reconfigured
+	_, err = server.ConfigureMinter(goCtx, types.NewMsgConfigureMinter(controller, minter, allowance.AddAmount(sdk.NewInt(1))))
+	require.NoError(t, err)
+
+	_, err = server.ConfigureMinter(goCtx, types.NewMsgConfigureMinter(controller, otherMinter, allowance))
+	require.ErrorIs(t, err, types.ErrMaxMinters)
+
+	_, err = server.IncreaseMinterAllowance(goCtx, types.NewMsgIncreaseMinterAllowance(controller, otherMinter, allowance, nil))
+	require.ErrorIs(t, err, types.ErrMaxMinters)
+
+	params.MaxMinters = 0
+	tf.SetParams(ctx, params)
+	_, err = server.ConfigureMinter(goCtx, types.NewMsgConfigureMinter(controller, otherMinter, allowance))
+	require.NoError(t, err)
+}
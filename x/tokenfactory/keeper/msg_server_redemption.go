@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgRequestRedemption) (*types.MsgRequestRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	denom := msg.Amount.Denom
+
+	if !k.HasMintingDenom(ctx, denom) {
+		return nil, sdkerrors.Wrapf(types.ErrNotMintingDenom, "%s", denom)
+	}
+
+	_, addressBz, err := bech32.DecodeAndConvert(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	_, found := k.GetBlacklisted(ctx, denom, addressBz)
+	if found {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "redeemer address is blacklisted")
+	}
+
+	paused := k.GetPaused(ctx, denom)
+
+	if paused.Paused {
+		return nil, sdkerrors.Wrapf(types.ErrPaused, "redeeming is paused")
+	}
+
+	redeemer, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, redeemer, types.ModuleName, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidRedemption, err.Error())
+	}
+
+	id := k.AppendRedemption(ctx, denom, types.Redemption{
+		Redeemer:      msg.From,
+		Amount:        msg.Amount,
+		BankReference: msg.BankReference,
+		Status:        types.REDEMPTION_STATUS_PENDING,
+		Height:        ctx.BlockHeight(),
+	})
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRedemptionRequested{
+		Id:            id,
+		Redeemer:      msg.From,
+		Amount:        msg.Amount,
+		BankReference: msg.BankReference,
+	})
+
+	return &types.MsgRequestRedemptionResponse{Id: id}, err
+}
+
+func (k msgServer) FinalizeRedemption(goCtx context.Context, msg *types.MsgFinalizeRedemption) (*types.MsgFinalizeRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	redemption, err := k.processRedemption(ctx, msg.From, msg.Denom, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	paused := k.GetPaused(ctx, msg.Denom)
+
+	if paused.Paused {
+		return nil, sdkerrors.Wrap(types.ErrBurn, "burning is paused")
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(redemption.Amount)); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrBurn, err.Error())
+	}
+
+	redemption.Status = types.REDEMPTION_STATUS_FINALIZED
+	redemption.Minter = msg.From
+	k.SetRedemption(ctx, msg.Denom, redemption)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRedemptionFinalized{
+		Id:       redemption.Id,
+		Minter:   msg.From,
+		Redeemer: redemption.Redeemer,
+		Amount:   redemption.Amount,
+	})
+
+	return &types.MsgFinalizeRedemptionResponse{}, err
+}
+
+func (k msgServer) CancelRedemption(goCtx context.Context, msg *types.MsgCancelRedemption) (*types.MsgCancelRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	redemption, err := k.processRedemption(ctx, msg.From, msg.Denom, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	paused := k.GetPaused(ctx, msg.Denom)
+
+	if paused.Paused {
+		return nil, sdkerrors.Wrapf(types.ErrPaused, "cancelling redemptions is paused")
+	}
+
+	redeemer, err := sdk.AccAddressFromBech32(redemption.Redeemer)
+	if err != nil {
+		return nil, err
+	}
+
+	// a redeemer blacklisted in the meantime keeps its escrow locked until it
+	// is either unblacklisted or the redemption is finalized
+	_, found := k.GetBlacklisted(ctx, msg.Denom, redeemer)
+	if found {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "redeemer address is blacklisted")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, redeemer, sdk.NewCoins(redemption.Amount)); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrSendCoinsToAccount, err.Error())
+	}
+
+	redemption.Status = types.REDEMPTION_STATUS_CANCELLED
+	redemption.Minter = msg.From
+	k.SetRedemption(ctx, msg.Denom, redemption)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRedemptionCancelled{
+		Id:       redemption.Id,
+		Minter:   msg.From,
+		Redeemer: redemption.Redeemer,
+		Amount:   redemption.Amount,
+	})
+
+	return &types.MsgCancelRedemptionResponse{}, err
+}
+
+// processRedemption returns the redemption id of denom if it is pending and
+// from is a minter of denom allowed to finalize or cancel it.
+func (k Keeper) processRedemption(ctx sdk.Context, from string, denom string, id uint64) (types.Redemption, error) {
+	redemption, found := k.GetRedemption(ctx, denom, id)
+	if !found {
+		return types.Redemption{}, sdkerrors.Wrapf(types.ErrRedemptionUnknown, "redemption %d of %s", id, denom)
+	}
+
+	if _, found := k.GetMinters(ctx, denom, from); !found {
+		return types.Redemption{}, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a minter")
+	}
+
+	_, addressBz, err := bech32.DecodeAndConvert(from)
+	if err != nil {
+		return types.Redemption{}, err
+	}
+
+	_, found = k.GetBlacklisted(ctx, denom, addressBz)
+	if found {
+		return types.Redemption{}, sdkerrors.Wrapf(types.ErrUnauthorized, "minter address is blacklisted")
+	}
+
+	if redemption.Status != types.REDEMPTION_STATUS_PENDING {
+		return types.Redemption{}, sdkerrors.Wrapf(types.ErrInvalidRedemption, "redemption %d is %s", id, redemption.Status)
+	}
+
+	return redemption, nil
+}
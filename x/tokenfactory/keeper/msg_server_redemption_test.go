@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestRequestRedemption(t *testing.T) {
+	tf, ctx := keepertest.TokenfactoryKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+	server := keeper.NewMsgServerImpl(tf)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	redeemer := sample.AccAddress()
+	amount := sdk.NewInt64Coin(testDenom, 100)
+
+	_, err := server.RequestRedemption(goCtx, types.NewMsgRequestRedemption(redeemer, amount, "wire 42"))
+	require.ErrorIs(t, err, types.ErrNotMintingDenom)
+
+	tf.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	tf.SetPaused(ctx, testDenom, types.Paused{Paused: true})
+	_, err = server.RequestRedemption(goCtx, types.NewMsgRequestRedemption(redeemer, amount, "wire 42"))
+	require.ErrorIs(t, err, types.ErrPaused)
+
+	tf.SetPaused(ctx, testDenom, types.Paused{Paused: false})
+	blacklisted := sample.AccAddress()
+	tf.SetBlacklisted(ctx, testDenom, types.Blacklisted{AddressBz: sdk.MustAccAddressFromBech32(blacklisted)})
+	_, err = server.RequestRedemption(goCtx, types.NewMsgRequestRedemption(blacklisted, amount, "wire 42"))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	res, err := server.RequestRedemption(goCtx, types.NewMsgRequestRedemption(redeemer, amount, "wire 42"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.Id)
+	require.Equal(t, &types.EventRedemptionRequested{
+		Id:            0,
+		Redeemer:      redeemer,
+		Amount:        amount,
+		BankReference: "wire 42",
+	}, lastEvent(t, ctx))
+
+	redemption, found := tf.GetRedemption(ctx, testDenom, 0)
+	require.True(t, found)
+	require.Equal(t, types.Redemption{
+		Id:            0,
+		Redeemer:      redeemer,
+		Amount:        amount,
+		BankReference: "wire 42",
+		Status:        types.REDEMPTION_STATUS_PENDING,
+		Height:        10,
+	}, redemption)
+}
+
+func TestFinalizeAndCancelRedemption(t *testing.T) {
+	tf, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(tf)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	minter := sample.AccAddress()
+	redeemer := sample.AccAddress()
+	amount := sdk.NewInt64Coin(testDenom, 100)
+	tf.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	tf.SetPaused(ctx, testDenom, types.Paused{Paused: false})
+	tf.SetMinters(ctx, testDenom, types.Minters{Address: minter, Allowance: sdk.NewInt64Coin(testDenom, 0)})
+
+	for i := 0; i < 3; i++ {
+		_, err := server.RequestRedemption(goCtx, types.NewMsgRequestRedemption(redeemer, amount, "wire 42"))
+		require.NoError(t, err)
+	}
+
+	_, err := server.FinalizeRedemption(goCtx, types.NewMsgFinalizeRedemption(minter, testDenom, 3))
+	require.ErrorIs(t, err, types.ErrRedemptionUnknown)
+
+	_, err = server.FinalizeRedemption(goCtx, types.NewMsgFinalizeRedemption(redeemer, testDenom, 0))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.FinalizeRedemption(goCtx, types.NewMsgFinalizeRedemption(minter, testDenom, 0))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventRedemptionFinalized{
+		Id:       0,
+		Minter:   minter,
+		Redeemer: redeemer,
+		Amount:   amount,
+	}, lastEvent(t, ctx))
+
+	_, err = server.CancelRedemption(goCtx, types.NewMsgCancelRedemption(minter, testDenom, 0))
+	require.ErrorIs(t, err, types.ErrInvalidRedemption)
+
+	tf.SetPaused(ctx, testDenom, types.Paused{Paused: true})
+	_, err = server.CancelRedemption(goCtx, types.NewMsgCancelRedemption(minter, testDenom, 1))
+	require.ErrorIs(t, err, types.ErrPaused)
+	tf.SetPaused(ctx, testDenom, types.Paused{Paused: false})
+
+	_, err = server.CancelRedemption(goCtx, types.NewMsgCancelRedemption(minter, testDenom, 1))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventRedemptionCancelled{
+		Id:       1,
+		Minter:   minter,
+		Redeemer: redeemer,
+		Amount:   amount,
+	}, lastEvent(t, ctx))
+
+	// the escrow of a blacklisted redeemer can only be burned
+	tf.SetBlacklisted(ctx, testDenom, types.Blacklisted{AddressBz: sdk.MustAccAddressFromBech32(redeemer)})
+	_, err = server.CancelRedemption(goCtx, types.NewMsgCancelRedemption(minter, testDenom, 2))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	finalized, err := tf.Redemptions(goCtx, &types.QueryRedemptionsRequest{Denom: testDenom, Status: types.REDEMPTION_STATUS_FINALIZED})
+	require.NoError(t, err)
+	require.Len(t, finalized.Redemptions, 1)
+	require.Equal(t, minter, finalized.Redemptions[0].Minter)
+
+	pending, err := tf.Redemptions(goCtx, &types.QueryRedemptionsRequest{Denom: testDenom, Status: types.REDEMPTION_STATUS_PENDING, Redeemer: redeemer})
+	require.NoError(t, err)
+	require.Len(t, pending.Redemptions, 1)
+	require.Equal(t, uint64(2), pending.Redemptions[0].Id)
+
+	all, err := tf.Redemptions(goCtx, &types.QueryRedemptionsRequest{Denom: testDenom, Redeemer: sample.AccAddress()})
+	require.NoError(t, err)
+	require.Empty(t, all.Redemptions)
+}
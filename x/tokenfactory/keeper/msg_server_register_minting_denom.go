@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RegisterMintingDenom(goCtx context.Context, msg *types.MsgRegisterMintingDenom) (*types.MsgRegisterMintingDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if authority := k.paramsKeeper.GetAuthority(ctx); authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the authority")
+	}
+
+	if _, found := k.GetFactoryDenom(ctx, msg.Denom); found || k.HasMintingDenom(ctx, msg.Denom) {
+		return nil, sdkerrors.Wrapf(types.ErrDenomExists, "%s", msg.Denom)
+	}
+
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, msg.Denom); !found {
+		return nil, sdkerrors.Wrapf(types.ErrDenomNotRegistered, "%s has no bank metadata", msg.Denom)
+	}
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: msg.Denom})
+	k.SetPaused(ctx, msg.Denom, types.Paused{Paused: false})
+	k.SetOwner(ctx, msg.Denom, types.Owner{Address: msg.Owner})
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMintingDenomRegistered{
+		Denom: msg.Denom,
+		Owner: msg.Owner,
+	})
+
+	return &types.MsgRegisterMintingDenomResponse{}, err
+}
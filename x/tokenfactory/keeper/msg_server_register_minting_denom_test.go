@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestRegisterMintingDenom(t *testing.T) {
+	bankKeeper := keepertest.NewMockMetadataBankKeeper()
+	tf, ctx := keepertest.TokenfactoryKeeperWithBankKeeper(t, bankKeeper)
+	server := keeper.NewMsgServerImpl(tf)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	owner := sample.AccAddress()
+
+	_, err := server.RegisterMintingDenom(goCtx, types.NewMsgRegisterMintingDenom(sample.AccAddress(), testDenom, owner))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.RegisterMintingDenom(goCtx, types.NewMsgRegisterMintingDenom(keepertest.MockAuthority, testDenom, owner))
+	require.ErrorIs(t, err, types.ErrDenomNotRegistered)
+
+	bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{Base: testDenom})
+	_, err = server.RegisterMintingDenom(goCtx, types.NewMsgRegisterMintingDenom(keepertest.MockAuthority, testDenom, owner))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMintingDenomRegistered{
+		Denom: testDenom,
+		Owner: owner,
+	}, lastEvent(t, ctx))
+
+	require.True(t, tf.HasMintingDenom(ctx, testDenom))
+	require.False(t, tf.GetPaused(ctx, testDenom).Paused)
+	got, found := tf.GetOwner(ctx, testDenom)
+	require.True(t, found)
+	require.Equal(t, owner, got.Address)
+
+	_, err = server.RegisterMintingDenom(goCtx, types.NewMsgRegisterMintingDenom(keepertest.MockAuthority, testDenom, owner))
+	require.ErrorIs(t, err, types.ErrDenomExists)
+
+	// factory denoms are rejected even if they passed ValidateBasic
+	factoryDenom, err := types.GetFactoryDenom(sample.AccAddress(), "utoken")
+	require.NoError(t, err)
+	tf.SetFactoryDenom(ctx, types.FactoryDenom{Denom: factoryDenom})
+	bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{Base: factoryDenom})
+	msg := types.NewMsgRegisterMintingDenom(keepertest.MockAuthority, factoryDenom, owner)
+	require.Error(t, msg.ValidateBasic())
+	_, err = server.RegisterMintingDenom(goCtx, msg)
+	require.ErrorIs(t, err, types.ErrDenomExists)
+	require.False(t, tf.HasMintingDenom(ctx, factoryDenom))
+}
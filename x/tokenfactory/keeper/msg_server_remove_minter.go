@@ -12,31 +12,28 @@ import (
 func (k msgServer) RemoveMinter(goCtx context.Context, msg *types.MsgRemoveMinter) (*types.MsgRemoveMinterResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	minterController, found := k.GetMinterController(ctx, msg.From)
-	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "minter controller not found")
-	}
-
-	if msg.From != minterController.Controller {
-		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a controller of this minter")
+	minterController, err := k.checkMinterController(ctx, msg.Denom, msg.From, msg.Address)
+	if err != nil {
+		return nil, err
 	}
 
-	if msg.Address != minterController.Minter {
-		return nil, sdkerrors.Wrapf(
-			types.ErrUnauthorized,
-			"minter address ≠ minter controller's minter address, (%s≠%s)",
-			msg.Address, minterController.Minter,
-		)
-	}
-
-	minter, found := k.GetMinters(ctx, msg.Address)
+	minter, found := k.GetMinters(ctx, msg.Denom, msg.Address)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "a minter with a given address doesn't exist")
 	}
 
-	k.RemoveMinters(ctx, minter.Address)
+	// the remaining allowance no longer counts towards the allowance cap
+	if err := k.grantAllowance(ctx, msg.Denom, minterController, minter.Allowance, sdk.NewCoin(msg.Denom, sdk.ZeroInt())); err != nil {
+		return nil, err
+	}
+
+	k.RemoveMinters(ctx, msg.Denom, minter.Address)
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMinterRemoved{
+		Controller: msg.From,
+		Minter:     minter.Address,
+		Allowance:  minter.Allowance,
+	})
 
 	return &types.MsgRemoveMinterResponse{}, err
 }
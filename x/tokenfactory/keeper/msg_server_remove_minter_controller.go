@@ -12,7 +12,7 @@ import (
 func (k msgServer) RemoveMinterController(goCtx context.Context, msg *types.MsgRemoveMinterController) (*types.MsgRemoveMinterControllerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	masterMinter, found := k.GetMasterMinter(ctx)
+	masterMinter, found := k.GetMasterMinter(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "master minter is not set")
 	}
@@ -21,12 +21,31 @@ func (k msgServer) RemoveMinterController(goCtx context.Context, msg *types.MsgR
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the master minter")
 	}
 
-	_, found = k.GetMinterController(ctx, msg.Controller)
-	if !found {
+	var controllers []types.MinterController
+	if msg.Minter != "" {
+		controller, found := k.GetMinterController(ctx, msg.Denom, msg.Controller, msg.Minter)
+		if found {
+			controllers = append(controllers, controller)
+		}
+	} else {
+		controllers = k.GetMinterControllersByController(ctx, msg.Denom, msg.Controller)
+	}
+	if len(controllers) == 0 {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "minter controller with a given address (%s) doesn't exist", msg.Controller)
 	}
 
-	k.DeleteMinterController(ctx, msg.Controller)
+	for _, controller := range controllers {
+		k.DeleteMinterController(ctx, msg.Denom, controller.Controller, controller.Minter)
+
+		err := ctx.EventManager().EmitTypedEvent(&types.EventMinterControllerRemoved{
+			Denom:      msg.Denom,
+			Controller: controller.Controller,
+			Minter:     controller.Minter,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return &types.MsgRemoveMinterControllerResponse{}, nil
 }
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	denom := msg.Metadata.Base
+
+	if !k.HasMintingDenom(ctx, denom) {
+		return nil, sdkerrors.Wrapf(types.ErrNotMintingDenom, "%s", denom)
+	}
+
+	owner, found := k.GetOwner(ctx, denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
+	}
+
+	if owner.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, msg.Metadata)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventDenomMetadataSet{
+		Denom:    denom,
+		Owner:    msg.From,
+		Metadata: msg.Metadata,
+	})
+
+	return &types.MsgSetDenomMetadataResponse{}, err
+}
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestSetDenomMetadata(t *testing.T) {
+	tf, ctx := keepertest.TokenfactoryKeeper(t)
+	server := keeper.NewMsgServerImpl(tf)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	owner := sample.AccAddress()
+	metadata := banktypes.Metadata{
+		Description: "Test Token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: testDenom, Exponent: 0, Aliases: []string{"microtoken"}},
+			{Denom: "token", Exponent: 6, Aliases: []string{"TOKEN"}},
+		},
+		Base:    testDenom,
+		Display: "token",
+		Name:    "Test Token",
+		Symbol:  "TOKEN",
+	}
+
+	_, err := server.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(owner, metadata))
+	require.ErrorIs(t, err, types.ErrNotMintingDenom)
+
+	tf.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	_, err = server.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(owner, metadata))
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+
+	tf.SetOwner(ctx, testDenom, types.Owner{Address: owner})
+	_, err = server.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(sample.AccAddress(), metadata))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = server.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(owner, metadata))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventDenomMetadataSet{
+		Denom:    testDenom,
+		Owner:    owner,
+		Metadata: metadata,
+	}, lastEvent(t, ctx))
+}
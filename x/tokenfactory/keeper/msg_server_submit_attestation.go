@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SubmitAttestation(goCtx context.Context, msg *types.MsgSubmitAttestation) (*types.MsgSubmitAttestationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	denom := msg.Reserves.Denom
+
+	if !k.HasMintingDenom(ctx, denom) {
+		return nil, sdkerrors.Wrapf(types.ErrNotMintingDenom, "%s", denom)
+	}
+
+	attestor, found := k.GetAttestor(ctx, denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "attestor is not set")
+	}
+
+	if attestor.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the attestor")
+	}
+
+	if msg.Timestamp.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAttestation, "timestamp %s is after the block time", msg.Timestamp)
+	}
+
+	latest, found := k.GetLatestAttestation(ctx, denom)
+	if found && !msg.Timestamp.After(latest.Timestamp) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAttestation, "timestamp %s is not after the one of the latest attestation", msg.Timestamp)
+	}
+
+	id := k.AppendAttestation(ctx, denom, types.Attestation{
+		Attestor:  msg.From,
+		Reserves:  msg.Reserves,
+		Timestamp: msg.Timestamp,
+		Uri:       msg.Uri,
+		Hash:      msg.Hash,
+		Height:    ctx.BlockHeight(),
+	})
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventAttestationSubmitted{
+		Id:        id,
+		Attestor:  msg.From,
+		Reserves:  msg.Reserves,
+		Timestamp: msg.Timestamp,
+		Uri:       msg.Uri,
+		Hash:      msg.Hash,
+	})
+
+	return &types.MsgSubmitAttestationResponse{Id: id}, err
+}
+
+// checkReserves returns an error if minting amount would bring the supply of
+// its denom above the reserves of the latest attestation, while the
+// MintWithinReservesDenoms param lists the denom.
+func (k Keeper) checkReserves(ctx sdk.Context, amount sdk.Coin) error {
+	if !k.MintWithinReserves(ctx, amount.Denom) {
+		return nil
+	}
+
+	latest, found := k.GetLatestAttestation(ctx, amount.Denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrMint, "no reserves are attested for %s", amount.Denom)
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, amount.Denom)
+	if latest.Reserves.IsLT(supply.Add(amount)) {
+		return sdkerrors.Wrapf(types.ErrMint, "minting %s would exceed the attested reserves of %s", amount, latest.Reserves)
+	}
+
+	return nil
+}
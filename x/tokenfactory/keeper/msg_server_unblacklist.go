@@ -13,7 +13,7 @@ import (
 func (k msgServer) Unblacklist(goCtx context.Context, msg *types.MsgUnblacklist) (*types.MsgUnblacklistResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	blacklister, found := k.GetBlacklister(ctx)
+	blacklister, found := k.GetBlacklister(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "blacklister is not set")
 	}
@@ -27,14 +27,18 @@ func (k msgServer) Unblacklist(goCtx context.Context, msg *types.MsgUnblacklist)
 		return nil, err
 	}
 
-	blacklisted, found := k.GetBlacklisted(ctx, addressBz)
+	blacklisted, found := k.GetBlacklisted(ctx, msg.Denom, addressBz)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "the specified address is not blacklisted")
 	}
 
-	k.RemoveBlacklisted(ctx, blacklisted.AddressBz)
+	k.RemoveBlacklisted(ctx, msg.Denom, blacklisted.AddressBz)
 
-	err = ctx.EventManager().EmitTypedEvent(msg)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventUnblacklisted{
+		Denom:     msg.Denom,
+		Address:   msg.Address,
+		AddressBz: blacklisted.AddressBz,
+	})
 
 	return &types.MsgUnblacklistResponse{}, err
 }
@@ -12,7 +12,7 @@ import (
 func (k msgServer) Unpause(goCtx context.Context, msg *types.MsgUnpause) (*types.MsgUnpauseResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	pauser, found := k.GetPauser(ctx)
+	pauser, found := k.GetPauser(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "pauser is not set")
 	}
@@ -21,13 +21,19 @@ func (k msgServer) Unpause(goCtx context.Context, msg *types.MsgUnpause) (*types
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the pauser")
 	}
 
+	previousPaused := k.PausedSet(ctx, msg.Denom) && k.GetPaused(ctx, msg.Denom).Paused
+
 	paused := types.Paused{
 		Paused: false,
 	}
 
-	k.SetPaused(ctx, paused)
+	k.SetPaused(ctx, msg.Denom, paused)
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventUnpaused{
+		Denom:          msg.Denom,
+		Pauser:         msg.From,
+		PreviousPaused: previousPaused,
+	})
 
 	return &types.MsgUnpauseResponse{}, err
 }
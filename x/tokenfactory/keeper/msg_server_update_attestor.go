@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) UpdateAttestor(goCtx context.Context, msg *types.MsgUpdateAttestor) (*types.MsgUpdateAttestorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, found := k.GetOwner(ctx, msg.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
+	}
+
+	if owner.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
+	}
+
+	// ensure that the specified address is not already assigned to a privileged role
+	err := k.ValidatePrivileges(ctx, msg.Denom, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, _ := k.GetAttestor(ctx, msg.Denom)
+
+	attestor := types.Attestor{
+		Address: msg.Address,
+	}
+
+	k.SetAttestor(ctx, msg.Denom, attestor)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAttestorUpdated{
+		Denom:            msg.Denom,
+		PreviousAttestor: previous.Address,
+		Attestor:         attestor.Address,
+	})
+
+	return &types.MsgUpdateAttestorResponse{}, err
+}
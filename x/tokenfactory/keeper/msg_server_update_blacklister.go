@@ -12,7 +12,7 @@ import (
 func (k msgServer) UpdateBlacklister(goCtx context.Context, msg *types.MsgUpdateBlacklister) (*types.MsgUpdateBlacklisterResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	owner, found := k.GetOwner(ctx)
+	owner, found := k.GetOwner(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
 	}
@@ -22,18 +22,24 @@ func (k msgServer) UpdateBlacklister(goCtx context.Context, msg *types.MsgUpdate
 	}
 
 	// ensure that the specified address is not already assigned to a privileged role
-	err := k.ValidatePrivileges(ctx, msg.Address)
+	err := k.ValidatePrivileges(ctx, msg.Denom, msg.Address)
 	if err != nil {
 		return nil, err
 	}
 
+	previous, _ := k.GetBlacklister(ctx, msg.Denom)
+
 	blacklister := types.Blacklister{
 		Address: msg.Address,
 	}
 
-	k.SetBlacklister(ctx, blacklister)
+	k.SetBlacklister(ctx, msg.Denom, blacklister)
 
-	err = ctx.EventManager().EmitTypedEvent(msg)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventBlacklisterUpdated{
+		Denom:               msg.Denom,
+		PreviousBlacklister: previous.Address,
+		Blacklister:         blacklister.Address,
+	})
 
 	return &types.MsgUpdateBlacklisterResponse{}, err
 }
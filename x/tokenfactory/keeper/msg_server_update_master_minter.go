@@ -12,7 +12,7 @@ import (
 func (k msgServer) UpdateMasterMinter(goCtx context.Context, msg *types.MsgUpdateMasterMinter) (*types.MsgUpdateMasterMinterResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	owner, found := k.GetOwner(ctx)
+	owner, found := k.GetOwner(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
 	}
@@ -22,18 +22,24 @@ func (k msgServer) UpdateMasterMinter(goCtx context.Context, msg *types.MsgUpdat
 	}
 
 	// ensure that the specified address is not already assigned to a privileged role
-	err := k.ValidatePrivileges(ctx, msg.Address)
+	err := k.ValidatePrivileges(ctx, msg.Denom, msg.Address)
 	if err != nil {
 		return nil, err
 	}
 
+	previous, _ := k.GetMasterMinter(ctx, msg.Denom)
+
 	masterMinter := types.MasterMinter{
 		Address: msg.Address,
 	}
 
-	k.SetMasterMinter(ctx, masterMinter)
+	k.SetMasterMinter(ctx, msg.Denom, masterMinter)
 
-	err = ctx.EventManager().EmitTypedEvent(msg)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMasterMinterUpdated{
+		Denom:                msg.Denom,
+		PreviousMasterMinter: previous.Address,
+		MasterMinter:         masterMinter.Address,
+	})
 
 	return &types.MsgUpdateMasterMinterResponse{}, err
 }
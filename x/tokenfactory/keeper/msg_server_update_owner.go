@@ -12,7 +12,7 @@ import (
 func (k msgServer) UpdateOwner(goCtx context.Context, msg *types.MsgUpdateOwner) (*types.MsgUpdateOwnerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	owner, found := k.GetOwner(ctx)
+	owner, found := k.GetOwner(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
 	}
@@ -22,16 +22,25 @@ func (k msgServer) UpdateOwner(goCtx context.Context, msg *types.MsgUpdateOwner)
 	}
 
 	// ensure that the specified address is not already assigned to a privileged role
-	err := k.ValidatePrivileges(ctx, msg.Address)
+	err := k.ValidatePrivileges(ctx, msg.Denom, msg.Address)
 	if err != nil {
 		return nil, err
 	}
 
-	owner.Address = msg.Address
+	previousPendingOwner, _ := k.GetPendingOwner(ctx, msg.Denom)
 
-	k.SetPendingOwner(ctx, owner)
+	pendingOwner := types.Owner{
+		Address: msg.Address,
+	}
+
+	k.SetPendingOwner(ctx, msg.Denom, pendingOwner)
 
-	err = ctx.EventManager().EmitTypedEvent(msg)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventOwnershipTransferStarted{
+		Denom:                msg.Denom,
+		Owner:                owner.Address,
+		PreviousPendingOwner: previousPendingOwner.Address,
+		PendingOwner:         pendingOwner.Address,
+	})
 
 	return &types.MsgUpdateOwnerResponse{}, err
 }
@@ -12,7 +12,7 @@ import (
 func (k msgServer) UpdatePauser(goCtx context.Context, msg *types.MsgUpdatePauser) (*types.MsgUpdatePauserResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	owner, found := k.GetOwner(ctx)
+	owner, found := k.GetOwner(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
 	}
@@ -22,18 +22,24 @@ func (k msgServer) UpdatePauser(goCtx context.Context, msg *types.MsgUpdatePause
 	}
 
 	// ensure that the specified address is not already assigned to a privileged role
-	err := k.ValidatePrivileges(ctx, msg.Address)
+	err := k.ValidatePrivileges(ctx, msg.Denom, msg.Address)
 	if err != nil {
 		return nil, err
 	}
 
+	previous, _ := k.GetPauser(ctx, msg.Denom)
+
 	pauser := types.Pauser{
 		Address: msg.Address,
 	}
 
-	k.SetPauser(ctx, pauser)
+	k.SetPauser(ctx, msg.Denom, pauser)
 
-	err = ctx.EventManager().EmitTypedEvent(msg)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventPauserUpdated{
+		Denom:          msg.Denom,
+		PreviousPauser: previous.Address,
+		Pauser:         pauser.Address,
+	})
 
 	return &types.MsgUpdatePauserResponse{}, err
 }
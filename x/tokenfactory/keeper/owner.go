@@ -7,15 +7,15 @@ import (
 )
 
 // SetOwner set owner in the store
-func (k Keeper) SetOwner(ctx sdk.Context, owner types.Owner) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) SetOwner(ctx sdk.Context, denom string, owner types.Owner) {
+	store := k.denomStore(ctx, denom)
 	b := k.cdc.MustMarshal(&owner)
 	store.Set(types.KeyPrefix(types.OwnerKey), b)
 }
 
 // GetOwner returns owner
-func (k Keeper) GetOwner(ctx sdk.Context) (val types.Owner, found bool) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) GetOwner(ctx sdk.Context, denom string) (val types.Owner, found bool) {
+	store := k.denomStore(ctx, denom)
 
 	b := store.Get(types.KeyPrefix(types.OwnerKey))
 	if b == nil {
@@ -27,21 +27,21 @@ func (k Keeper) GetOwner(ctx sdk.Context) (val types.Owner, found bool) {
 }
 
 // SetPendingOwner set pending owner in the store
-func (k Keeper) SetPendingOwner(ctx sdk.Context, owner types.Owner) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) SetPendingOwner(ctx sdk.Context, denom string, owner types.Owner) {
+	store := k.denomStore(ctx, denom)
 	b := k.cdc.MustMarshal(&owner)
 	store.Set(types.KeyPrefix(types.PendingOwnerKey), b)
 }
 
 // DeletePendingOwner deletes the pending owner in the store
-func (k Keeper) DeletePendingOwner(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) DeletePendingOwner(ctx sdk.Context, denom string) {
+	store := k.denomStore(ctx, denom)
 	store.Delete(types.KeyPrefix(types.PendingOwnerKey))
 }
 
 // GetPendingOwner returns pending owner
-func (k Keeper) GetPendingOwner(ctx sdk.Context) (val types.Owner, found bool) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) GetPendingOwner(ctx sdk.Context, denom string) (val types.Owner, found bool) {
+	store := k.denomStore(ctx, denom)
 
 	b := store.Get(types.KeyPrefix(types.PendingOwnerKey))
 	if b == nil {
@@ -15,9 +15,9 @@ func TestOwnerGet(t *testing.T) {
 	keeper, ctx := keepertest.TokenfactoryKeeper(t)
 
 	owner := types.Owner{Address: "1"}
-	keeper.SetOwner(ctx, owner)
+	keeper.SetOwner(ctx, testDenom, owner)
 
-	rst, found := keeper.GetOwner(ctx)
+	rst, found := keeper.GetOwner(ctx, testDenom)
 	require.True(t, found)
 	require.Equal(t,
 		owner,
@@ -26,9 +26,9 @@ func TestOwnerGet(t *testing.T) {
 
 	newOwner := types.Owner{Address: "2"}
 
-	keeper.SetPendingOwner(ctx, newOwner)
+	keeper.SetPendingOwner(ctx, testDenom, newOwner)
 
-	rst, found = keeper.GetPendingOwner(ctx)
+	rst, found = keeper.GetPendingOwner(ctx, testDenom)
 	require.True(t, found)
 	require.Equal(t,
 		newOwner,
@@ -1,16 +1,107 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
 )
 
 // GetParams get all parameters as types.Params
-func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramstore.GetParamSet(ctx, &params)
+	return params
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// RejectBlacklistedSigners returns the RejectBlacklistedSigners param, or
+// false if it is not set yet, as while delivering the genesis txs.
+func (k Keeper) RejectBlacklistedSigners(ctx sdk.Context) (res bool) {
+	k.paramstore.GetIfExists(ctx, types.KeyRejectBlacklistedSigners, &res)
+	return
+}
+
+// TravelRuleEnabled returns the TravelRuleEnabled param, or false if it is
+// not set yet.
+func (k Keeper) TravelRuleEnabled(ctx sdk.Context) (res bool) {
+	k.paramstore.GetIfExists(ctx, types.KeyTravelRuleEnabled, &res)
+	return
+}
+
+// TravelRuleThreshold returns the TravelRuleThreshold param.
+func (k Keeper) TravelRuleThreshold(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeyTravelRuleThreshold, &res)
+	return
+}
+
+// DenomCreationFee returns the DenomCreationFee param.
+func (k Keeper) DenomCreationFee(ctx sdk.Context) (res sdk.Coins) {
+	k.paramstore.Get(ctx, types.KeyDenomCreationFee, &res)
+	return
+}
+
+// MintWithinReserves returns true if the MintWithinReservesDenoms param
+// bounds the mints of denom by its attested reserves.
+func (k Keeper) MintWithinReserves(ctx sdk.Context, denom string) bool {
+	var denoms []string
+	k.paramstore.Get(ctx, types.KeyMintWithinReservesDenoms, &denoms)
+
+	for _, d := range denoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// MintApprovalThreshold returns the amount of denom above which a mint needs
+// an approval, if the MintApprovalThresholds param sets one.
+func (k Keeper) MintApprovalThreshold(ctx sdk.Context, denom string) (sdk.Coin, bool) {
+	var thresholds sdk.Coins
+	k.paramstore.Get(ctx, types.KeyMintApprovalThresholds, &thresholds)
+
+	amount := thresholds.AmountOf(denom)
+	return sdk.NewCoin(denom, amount), amount.IsPositive()
+}
+
+// MintApprovalWindow returns the MintApprovalWindow param.
+func (k Keeper) MintApprovalWindow(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyMintApprovalWindow, &res)
+	return
+}
+
+// MaxMintAmount returns the max amount of denom a single mint can mint, if
+// the MaxMintAmounts param sets one.
+func (k Keeper) MaxMintAmount(ctx sdk.Context, denom string) (sdk.Coin, bool) {
+	var maxMintAmounts sdk.Coins
+	k.paramstore.Get(ctx, types.KeyMaxMintAmounts, &maxMintAmounts)
+
+	amount := maxMintAmounts.AmountOf(denom)
+	return sdk.NewCoin(denom, amount), amount.IsPositive()
+}
+
+// SupplyCap returns the max supply of denom, if the SupplyCaps param sets
+// one.
+func (k Keeper) SupplyCap(ctx sdk.Context, denom string) (sdk.Coin, bool) {
+	var supplyCaps sdk.Coins
+	k.paramstore.Get(ctx, types.KeySupplyCaps, &supplyCaps)
+
+	amount := supplyCaps.AmountOf(denom)
+	return sdk.NewCoin(denom, amount), amount.IsPositive()
+}
+
+// MaxMinters returns the MaxMinters param.
+func (k Keeper) MaxMinters(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxMinters, &res)
+	return
+}
+
+// AllowBlacklistedIBCReceive returns the AllowBlacklistedIBCReceive param.
+func (k Keeper) AllowBlacklistedIBCReceive(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyAllowBlacklistedIBCReceive, &res)
+	return
+}
@@ -7,15 +7,15 @@ import (
 )
 
 // SetPaused set paused in the store
-func (k Keeper) SetPaused(ctx sdk.Context, paused types.Paused) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) SetPaused(ctx sdk.Context, denom string, paused types.Paused) {
+	store := k.denomStore(ctx, denom)
 	b := k.cdc.MustMarshal(&paused)
 	store.Set(types.KeyPrefix(types.PausedKey), b)
 }
 
 // GetPaused returns paused
-func (k Keeper) GetPaused(ctx sdk.Context) (val types.Paused) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) GetPaused(ctx sdk.Context, denom string) (val types.Paused) {
+	store := k.denomStore(ctx, denom)
 
 	b := store.Get(types.KeyPrefix(types.PausedKey))
 	if b == nil {
@@ -25,3 +25,9 @@ func (k Keeper) GetPaused(ctx sdk.Context) (val types.Paused) {
 	k.cdc.MustUnmarshal(b, &val)
 	return val
 }
+
+// PausedSet returns true if the Paused state is already set in the store, it returns false otherwise.
+func (k Keeper) PausedSet(ctx sdk.Context, denom string) bool {
+	store := k.denomStore(ctx, denom)
+	return store.Has(types.KeyPrefix(types.PausedKey))
+}
@@ -14,14 +14,14 @@ import (
 
 func createTestPaused(keeper *keeper.Keeper, ctx sdk.Context) types.Paused {
 	item := types.Paused{}
-	keeper.SetPaused(ctx, item)
+	keeper.SetPaused(ctx, testDenom, item)
 	return item
 }
 
 func TestPausedGet(t *testing.T) {
 	keeper, ctx := keepertest.TokenfactoryKeeper(t)
 	item := createTestPaused(keeper, ctx)
-	rst := keeper.GetPaused(ctx)
+	rst := keeper.GetPaused(ctx, testDenom)
 	require.Equal(t,
 		nullify.Fill(&item),
 		nullify.Fill(&rst),
@@ -7,15 +7,15 @@ import (
 )
 
 // SetPauser set pauser in the store
-func (k Keeper) SetPauser(ctx sdk.Context, pauser types.Pauser) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) SetPauser(ctx sdk.Context, denom string, pauser types.Pauser) {
+	store := k.denomStore(ctx, denom)
 	b := k.cdc.MustMarshal(&pauser)
 	store.Set(types.KeyPrefix(types.PauserKey), b)
 }
 
 // GetPauser returns pauser
-func (k Keeper) GetPauser(ctx sdk.Context) (val types.Pauser, found bool) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) GetPauser(ctx sdk.Context, denom string) (val types.Pauser, found bool) {
+	store := k.denomStore(ctx, denom)
 
 	b := store.Get(types.KeyPrefix(types.PauserKey))
 	if b == nil {
@@ -14,14 +14,14 @@ import (
 
 func createTestPauser(keeper *keeper.Keeper, ctx sdk.Context) types.Pauser {
 	item := types.Pauser{}
-	keeper.SetPauser(ctx, item)
+	keeper.SetPauser(ctx, testDenom, item)
 	return item
 }
 
 func TestPauserGet(t *testing.T) {
 	keeper, ctx := keepertest.TokenfactoryKeeper(t)
 	item := createTestPauser(keeper, ctx)
-	rst, found := keeper.GetPauser(ctx)
+	rst, found := keeper.GetPauser(ctx, testDenom)
 	require.True(t, found)
 	require.Equal(t,
 		nullify.Fill(&item),
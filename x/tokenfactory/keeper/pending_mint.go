@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPendingMintCount returns the number of mints ever left pending for a
+// minting denom, which is the id of the next one.
+func (k Keeper) GetPendingMintCount(ctx sdk.Context, denom string) uint64 {
+	store := k.denomStore(ctx, denom)
+
+	b := store.Get(types.KeyPrefix(types.PendingMintCountKey))
+	if b == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(b)
+}
+
+// SetPendingMintCount sets the number of pending mints of a minting denom
+func (k Keeper) SetPendingMintCount(ctx sdk.Context, denom string, count uint64) {
+	store := k.denomStore(ctx, denom)
+	store.Set(types.KeyPrefix(types.PendingMintCountKey), sdk.Uint64ToBigEndian(count))
+}
+
+// AppendPendingMint stores pendingMint under the next id and returns the id
+func (k Keeper) AppendPendingMint(ctx sdk.Context, denom string, pendingMint types.PendingMint) uint64 {
+	count := k.GetPendingMintCount(ctx, denom)
+
+	pendingMint.Id = count
+	k.SetPendingMint(ctx, denom, pendingMint)
+	k.SetPendingMintCount(ctx, denom, count+1)
+
+	return count
+}
+
+// SetPendingMint set a specific pendingMint in the store from its id, and
+// indexes it by expiry time
+func (k Keeper) SetPendingMint(ctx sdk.Context, denom string, pendingMint types.PendingMint) {
+	k.RemovePendingMint(ctx, denom, pendingMint.Id)
+
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.PendingMintKeyPrefix))
+	b := k.cdc.MustMarshal(&pendingMint)
+	store.Set(types.PendingMintKey(pendingMint.Id), b)
+
+	byExpiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingMintByExpiryKeyPrefix))
+	byExpiryStore.Set(types.PendingMintByExpiryKey(pendingMint.ExpiresAt, denom, pendingMint.Id), []byte{})
+}
+
+// GetPendingMint returns a pendingMint from its id
+func (k Keeper) GetPendingMint(ctx sdk.Context, denom string, id uint64) (val types.PendingMint, found bool) {
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.PendingMintKeyPrefix))
+
+	b := store.Get(types.PendingMintKey(id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePendingMint removes a pendingMint and its expiry index from the store
+func (k Keeper) RemovePendingMint(ctx sdk.Context, denom string, id uint64) {
+	pendingMint, found := k.GetPendingMint(ctx, denom, id)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.PendingMintKeyPrefix))
+	store.Delete(types.PendingMintKey(id))
+
+	byExpiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingMintByExpiryKeyPrefix))
+	byExpiryStore.Delete(types.PendingMintByExpiryKey(pendingMint.ExpiresAt, denom, id))
+}
+
+// GetAllPendingMints returns all pending mints of a minting denom, oldest
+// first
+func (k Keeper) GetAllPendingMints(ctx sdk.Context, denom string) (list []types.PendingMint) {
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.PendingMintKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PendingMint
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemoveExpiredPendingMints removes up to limit pending mints of any minting
+// denom which expire before the block time, the earliest expiring first.
+func (k Keeper) RemoveExpiredPendingMints(ctx sdk.Context, limit int) error {
+	// only the index entries expiring before the block time are iterated, and
+	// collected first as the store can not be written while iterated
+	byExpiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingMintByExpiryKeyPrefix))
+	iterator := byExpiryStore.Iterator(nil, sdk.FormatTimeBytes(ctx.BlockTime()))
+
+	var keys [][]byte
+	for ; iterator.Valid() && len(keys) < limit; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		byExpiryStore.Delete(key)
+
+		denom, id := types.ParsePendingMintByExpiryKey(key)
+		pendingMint, found := k.GetPendingMint(ctx, denom, id)
+		if !found {
+			continue
+		}
+
+		k.RemovePendingMint(ctx, denom, id)
+		k.releasePendingMintAllowance(ctx, denom, pendingMint)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventMintExpired{
+			Id:     pendingMint.Id,
+			Minter: pendingMint.Minter,
+			Denom:  denom,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// releasePendingMintAllowance gives the allowance reserved by a discarded
+// pending mint back to its minter, unless the minter was removed meanwhile.
+func (k Keeper) releasePendingMintAllowance(ctx sdk.Context, denom string, pendingMint types.PendingMint) {
+	minter, found := k.GetMinters(ctx, denom, pendingMint.Minter)
+	if !found {
+		return
+	}
+
+	minter.Allowance = minter.Allowance.Add(pendingMint.Amount)
+	k.SetMinters(ctx, denom, minter)
+}
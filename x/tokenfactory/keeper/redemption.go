@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetRedemptionCount returns the number of redemptions ever requested for a
+// minting denom, which is the id of the next one.
+func (k Keeper) GetRedemptionCount(ctx sdk.Context, denom string) uint64 {
+	store := k.denomStore(ctx, denom)
+
+	b := store.Get(types.KeyPrefix(types.RedemptionCountKey))
+	if b == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(b)
+}
+
+// SetRedemptionCount sets the number of redemptions of a minting denom
+func (k Keeper) SetRedemptionCount(ctx sdk.Context, denom string, count uint64) {
+	store := k.denomStore(ctx, denom)
+	store.Set(types.KeyPrefix(types.RedemptionCountKey), sdk.Uint64ToBigEndian(count))
+}
+
+// AppendRedemption stores redemption under the next id and returns the id
+func (k Keeper) AppendRedemption(ctx sdk.Context, denom string, redemption types.Redemption) uint64 {
+	count := k.GetRedemptionCount(ctx, denom)
+
+	redemption.Id = count
+	k.SetRedemption(ctx, denom, redemption)
+	k.SetRedemptionCount(ctx, denom, count+1)
+
+	return count
+}
+
+// SetRedemption set a specific redemption in the store from its id
+func (k Keeper) SetRedemption(ctx sdk.Context, denom string, redemption types.Redemption) {
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.RedemptionKeyPrefix))
+	b := k.cdc.MustMarshal(&redemption)
+	store.Set(types.RedemptionKey(redemption.Id), b)
+}
+
+// GetRedemption returns a redemption from its id
+func (k Keeper) GetRedemption(ctx sdk.Context, denom string, id uint64) (val types.Redemption, found bool) {
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.RedemptionKeyPrefix))
+
+	b := store.Get(types.RedemptionKey(id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllRedemptions returns all redemptions of a minting denom, oldest
+// first
+func (k Keeper) GetAllRedemptions(ctx sdk.Context, denom string) (list []types.Redemption) {
+	store := prefix.NewStore(k.denomStore(ctx, denom), types.KeyPrefix(types.RedemptionKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Redemption
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
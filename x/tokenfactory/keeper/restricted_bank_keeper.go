@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+var _ bankkeeper.Keeper = RestrictedBankKeeper{}
+
+// RestrictedBankKeeper wraps a bank keeper so that the minting denom of the
+// tokenfactory can not be moved to or from a blacklisted address, nor while
+// the tokenfactory is paused, whichever module moves it. Moves between two
+// module accounts are only subject to the blacklist, so that the fee
+// distribution in BeginBlock keeps working while paused.
+type RestrictedBankKeeper struct {
+	bankkeeper.Keeper
+
+	tokenFactory *Keeper
+}
+
+// allowedReceiverKey is the context key of the address allowed to receive
+// the minting denoms even though it is blacklisted.
+type allowedReceiverKey struct{}
+
+// WithAllowedReceiver returns a copy of ctx in which receiver can receive the
+// minting denoms even if it is blacklisted, as the IBC middleware does for the
+// receiver of a packet while the AllowBlacklistedIBCReceive param is enabled.
+func WithAllowedReceiver(ctx sdk.Context, receiver sdk.AccAddress) sdk.Context {
+	return ctx.WithValue(allowedReceiverKey{}, receiver.String())
+}
+
+func NewRestrictedBankKeeper(bankKeeper bankkeeper.Keeper, tokenFactory *Keeper) RestrictedBankKeeper {
+	return RestrictedBankKeeper{
+		Keeper:       bankKeeper,
+		tokenFactory: tokenFactory,
+	}
+}
+
+func (k RestrictedBankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.checkTransfer(ctx, amt, true, fromAddr, receiverToCheck(ctx, toAddr)); err != nil {
+		return err
+	}
+	return k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+func (k RestrictedBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	for _, input := range inputs {
+		addr, err := sdk.AccAddressFromBech32(input.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.checkTransfer(ctx, input.Coins, true, addr); err != nil {
+			return err
+		}
+	}
+	for _, output := range outputs {
+		addr, err := sdk.AccAddressFromBech32(output.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.checkTransfer(ctx, output.Coins, true, addr); err != nil {
+			return err
+		}
+	}
+	return k.Keeper.InputOutputCoins(ctx, inputs, outputs)
+}
+
+func (k RestrictedBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.checkTransfer(ctx, amt, true, authtypes.NewModuleAddress(senderModule), receiverToCheck(ctx, recipientAddr)); err != nil {
+		return err
+	}
+	return k.Keeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+}
+
+func (k RestrictedBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := k.checkTransfer(ctx, amt, true, senderAddr, authtypes.NewModuleAddress(recipientModule)); err != nil {
+		return err
+	}
+	return k.Keeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+func (k RestrictedBankKeeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	if err := k.checkTransfer(ctx, amt, false, authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule)); err != nil {
+		return err
+	}
+	return k.Keeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt)
+}
+
+func (k RestrictedBankKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.checkTransfer(ctx, amt, true, delegatorAddr, moduleAccAddr); err != nil {
+		return err
+	}
+	return k.Keeper.DelegateCoins(ctx, delegatorAddr, moduleAccAddr, amt)
+}
+
+func (k RestrictedBankKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.checkTransfer(ctx, amt, true, moduleAccAddr, delegatorAddr); err != nil {
+		return err
+	}
+	return k.Keeper.UndelegateCoins(ctx, moduleAccAddr, delegatorAddr, amt)
+}
+
+func (k RestrictedBankKeeper) DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := k.checkTransfer(ctx, amt, true, senderAddr, authtypes.NewModuleAddress(recipientModule)); err != nil {
+		return err
+	}
+	return k.Keeper.DelegateCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+func (k RestrictedBankKeeper) UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.checkTransfer(ctx, amt, true, authtypes.NewModuleAddress(senderModule), recipientAddr); err != nil {
+		return err
+	}
+	return k.Keeper.UndelegateCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+}
+
+// checkTransfer returns an error if amt contains a minting denom and any of
+// addrs is blacklisted for it or, if checkPaused is set, it is paused.
+func (k RestrictedBankKeeper) checkTransfer(ctx sdk.Context, amt sdk.Coins, checkPaused bool, addrs ...sdk.AccAddress) error {
+	for _, coin := range amt {
+		denom := coin.Denom
+		if coin.IsZero() || !k.tokenFactory.HasMintingDenom(ctx, denom) {
+			continue
+		}
+
+		if checkPaused && k.tokenFactory.GetPaused(ctx, denom).Paused {
+			return sdkerrors.Wrapf(types.ErrPaused, "can not transfer %s", denom)
+		}
+		for _, addr := range addrs {
+			if addr.Empty() {
+				continue
+			}
+			if _, found := k.tokenFactory.GetBlacklisted(ctx, denom, addr); found {
+				return sdkerrors.Wrapf(types.ErrUnauthorized, "an address (%s) is blacklisted and can not send or receive %s", addr, denom)
+			}
+		}
+	}
+
+	return nil
+}
+
+// receiverToCheck returns receiver, or nil if ctx allows it to receive even
+// though it is blacklisted.
+func receiverToCheck(ctx sdk.Context, receiver sdk.AccAddress) sdk.AccAddress {
+	if allowed, ok := ctx.Value(allowedReceiverKey{}).(string); ok && allowed == receiver.String() {
+		return nil
+	}
+	return receiver
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/keeper"
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+// sendOnlyBankKeeper accepts every send, and panics on any other call.
+type sendOnlyBankKeeper struct {
+	bankkeeper.Keeper
+}
+
+func (sendOnlyBankKeeper) SendCoins(sdk.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) error {
+	return nil
+}
+
+func (sendOnlyBankKeeper) InputOutputCoins(sdk.Context, []banktypes.Input, []banktypes.Output) error {
+	return nil
+}
+
+func (sendOnlyBankKeeper) SendCoinsFromModuleToAccount(sdk.Context, string, sdk.AccAddress, sdk.Coins) error {
+	return nil
+}
+
+func (sendOnlyBankKeeper) SendCoinsFromModuleToModule(sdk.Context, string, string, sdk.Coins) error {
+	return nil
+}
+
+func TestRestrictedBankKeeper(t *testing.T) {
+	tf, ctx := keepertest.TokenfactoryKeeper(t)
+	bank := keeper.NewRestrictedBankKeeper(sendOnlyBankKeeper{}, tf)
+
+	alice := sdk.AccAddress(sample.AddressBz())
+	bob := sdk.AccAddress(sample.AddressBz())
+	blacklisted := sdk.AccAddress(sample.AddressBz())
+	coins := sdk.NewCoins(sdk.NewInt64Coin(testDenom, 1))
+	otherCoins := sdk.NewCoins(sdk.NewInt64Coin("uother", 1))
+
+	// nothing is restricted before the minting denom is set
+	require.NoError(t, bank.SendCoins(ctx, alice, bob, coins))
+
+	tf.SetMintingDenom(ctx, types.MintingDenom{Denom: testDenom})
+	tf.SetPaused(ctx, testDenom, types.Paused{Paused: false})
+	tf.SetBlacklisted(ctx, testDenom, types.Blacklisted{AddressBz: blacklisted})
+
+	require.NoError(t, bank.SendCoins(ctx, alice, bob, coins))
+	require.NoError(t, bank.SendCoins(ctx, blacklisted, bob, otherCoins))
+	require.ErrorIs(t, bank.SendCoins(ctx, blacklisted, bob, coins), types.ErrUnauthorized)
+	require.ErrorIs(t, bank.SendCoins(ctx, alice, blacklisted, coins.Add(otherCoins...)), types.ErrUnauthorized)
+	require.ErrorIs(t, bank.SendCoinsFromModuleToAccount(ctx, distrtypes.ModuleName, blacklisted, coins), types.ErrUnauthorized)
+	require.ErrorIs(t, bank.InputOutputCoins(ctx,
+		[]banktypes.Input{banktypes.NewInput(alice, coins)},
+		[]banktypes.Output{banktypes.NewOutput(blacklisted, coins)},
+	), types.ErrUnauthorized)
+
+	// an allowed receiver can receive, but still not send
+	allowed := keeper.WithAllowedReceiver(ctx, blacklisted)
+	require.NoError(t, bank.SendCoins(allowed, alice, blacklisted, coins))
+	require.NoError(t, bank.SendCoinsFromModuleToAccount(allowed, distrtypes.ModuleName, blacklisted, coins))
+	require.ErrorIs(t, bank.SendCoins(allowed, blacklisted, bob, coins), types.ErrUnauthorized)
+	require.ErrorIs(t, bank.SendCoins(keeper.WithAllowedReceiver(ctx, bob), alice, blacklisted, coins), types.ErrUnauthorized)
+
+	tf.SetPaused(ctx, testDenom, types.Paused{Paused: true})
+	require.NoError(t, bank.SendCoins(ctx, alice, bob, otherCoins))
+	require.ErrorIs(t, bank.SendCoins(ctx, alice, bob, coins), types.ErrPaused)
+	require.ErrorIs(t, bank.SendCoinsFromModuleToAccount(ctx, distrtypes.ModuleName, alice, coins), types.ErrPaused)
+	// moves between module accounts are not paused
+	require.NoError(t, bank.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, distrtypes.ModuleName, coins))
+}
@@ -81,6 +81,66 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgRemoveMinterController int = 100
 
+	opWeightMsgIncreaseMinterAllowance = "op_weight_msg_increase_minter_allowance"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgIncreaseMinterAllowance int = 100
+
+	opWeightMsgDecreaseMinterAllowance = "op_weight_msg_decrease_minter_allowance"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgDecreaseMinterAllowance int = 100
+
+	opWeightMsgCreateDenom = "op_weight_msg_create_denom"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgCreateDenom int = 100
+
+	opWeightMsgMintFactoryDenom = "op_weight_msg_mint_factory_denom"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgMintFactoryDenom int = 100
+
+	opWeightMsgBurnFactoryDenom = "op_weight_msg_burn_factory_denom"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgBurnFactoryDenom int = 100
+
+	opWeightMsgChangeFactoryDenomAdmin = "op_weight_msg_change_factory_denom_admin"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgChangeFactoryDenomAdmin int = 100
+
+	opWeightMsgSetDenomMetadata = "op_weight_msg_set_denom_metadata"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSetDenomMetadata int = 100
+
+	opWeightMsgUpdateAttestor = "op_weight_msg_update_attestor"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgUpdateAttestor int = 100
+
+	opWeightMsgSubmitAttestation = "op_weight_msg_submit_attestation"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSubmitAttestation int = 100
+
+	opWeightMsgApproveMint = "op_weight_msg_approve_mint"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgApproveMint int = 100
+
+	opWeightMsgRejectMint = "op_weight_msg_reject_mint"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgRejectMint int = 100
+
+	opWeightMsgRequestRedemption = "op_weight_msg_request_redemption"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgRequestRedemption int = 100
+
+	opWeightMsgFinalizeRedemption = "op_weight_msg_finalize_redemption"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgFinalizeRedemption int = 100
+
+	opWeightMsgCancelRedemption = "op_weight_msg_cancel_redemption"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgCancelRedemption int = 100
+
+	opWeightMsgRegisterMintingDenom = "op_weight_msg_register_minting_denom"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgRegisterMintingDenom int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -300,6 +360,171 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		tokenfactorysimulation.SimulateMsgRemoveMinterController(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgIncreaseMinterAllowance int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgIncreaseMinterAllowance, &weightMsgIncreaseMinterAllowance, nil,
+		func(_ *rand.Rand) {
+			weightMsgIncreaseMinterAllowance = defaultWeightMsgIncreaseMinterAllowance
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgIncreaseMinterAllowance,
+		tokenfactorysimulation.SimulateMsgIncreaseMinterAllowance(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgDecreaseMinterAllowance int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgDecreaseMinterAllowance, &weightMsgDecreaseMinterAllowance, nil,
+		func(_ *rand.Rand) {
+			weightMsgDecreaseMinterAllowance = defaultWeightMsgDecreaseMinterAllowance
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDecreaseMinterAllowance,
+		tokenfactorysimulation.SimulateMsgDecreaseMinterAllowance(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgCreateDenom int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgCreateDenom, &weightMsgCreateDenom, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateDenom = defaultWeightMsgCreateDenom
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateDenom,
+		tokenfactorysimulation.SimulateMsgCreateDenom(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgMintFactoryDenom int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgMintFactoryDenom, &weightMsgMintFactoryDenom, nil,
+		func(_ *rand.Rand) {
+			weightMsgMintFactoryDenom = defaultWeightMsgMintFactoryDenom
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgMintFactoryDenom,
+		tokenfactorysimulation.SimulateMsgMintFactoryDenom(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgBurnFactoryDenom int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgBurnFactoryDenom, &weightMsgBurnFactoryDenom, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurnFactoryDenom = defaultWeightMsgBurnFactoryDenom
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgBurnFactoryDenom,
+		tokenfactorysimulation.SimulateMsgBurnFactoryDenom(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgChangeFactoryDenomAdmin int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgChangeFactoryDenomAdmin, &weightMsgChangeFactoryDenomAdmin, nil,
+		func(_ *rand.Rand) {
+			weightMsgChangeFactoryDenomAdmin = defaultWeightMsgChangeFactoryDenomAdmin
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgChangeFactoryDenomAdmin,
+		tokenfactorysimulation.SimulateMsgChangeFactoryDenomAdmin(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSetDenomMetadata int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSetDenomMetadata, &weightMsgSetDenomMetadata, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetDenomMetadata = defaultWeightMsgSetDenomMetadata
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetDenomMetadata,
+		tokenfactorysimulation.SimulateMsgSetDenomMetadata(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgUpdateAttestor int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgUpdateAttestor, &weightMsgUpdateAttestor, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateAttestor = defaultWeightMsgUpdateAttestor
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdateAttestor,
+		tokenfactorysimulation.SimulateMsgUpdateAttestor(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSubmitAttestation int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSubmitAttestation, &weightMsgSubmitAttestation, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitAttestation = defaultWeightMsgSubmitAttestation
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSubmitAttestation,
+		tokenfactorysimulation.SimulateMsgSubmitAttestation(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgApproveMint int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgApproveMint, &weightMsgApproveMint, nil,
+		func(_ *rand.Rand) {
+			weightMsgApproveMint = defaultWeightMsgApproveMint
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgApproveMint,
+		tokenfactorysimulation.SimulateMsgApproveMint(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgRejectMint int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgRejectMint, &weightMsgRejectMint, nil,
+		func(_ *rand.Rand) {
+			weightMsgRejectMint = defaultWeightMsgRejectMint
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRejectMint,
+		tokenfactorysimulation.SimulateMsgRejectMint(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgRequestRedemption int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgRequestRedemption, &weightMsgRequestRedemption, nil,
+		func(_ *rand.Rand) {
+			weightMsgRequestRedemption = defaultWeightMsgRequestRedemption
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRequestRedemption,
+		tokenfactorysimulation.SimulateMsgRequestRedemption(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgFinalizeRedemption int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgFinalizeRedemption, &weightMsgFinalizeRedemption, nil,
+		func(_ *rand.Rand) {
+			weightMsgFinalizeRedemption = defaultWeightMsgFinalizeRedemption
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgFinalizeRedemption,
+		tokenfactorysimulation.SimulateMsgFinalizeRedemption(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgCancelRedemption int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgCancelRedemption, &weightMsgCancelRedemption, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelRedemption = defaultWeightMsgCancelRedemption
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCancelRedemption,
+		tokenfactorysimulation.SimulateMsgCancelRedemption(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgRegisterMintingDenom int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgRegisterMintingDenom, &weightMsgRegisterMintingDenom, nil,
+		func(_ *rand.Rand) {
+			weightMsgRegisterMintingDenom = defaultWeightMsgRegisterMintingDenom
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRegisterMintingDenom,
+		tokenfactorysimulation.SimulateMsgRegisterMintingDenom(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
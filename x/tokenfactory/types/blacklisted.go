@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxIsBlacklistedBatchSize is the max number of addresses an
+// IsBlacklistedBatch query can check.
+const MaxIsBlacklistedBatchSize = 100
+
+// NewBlacklistedAddress returns blacklisted with its address as bech32 under
+// the chain prefix.
+func NewBlacklistedAddress(blacklisted Blacklisted) BlacklistedAddress {
+	return BlacklistedAddress{
+		Address:   sdk.AccAddress(blacklisted.AddressBz).String(),
+		AddressBz: blacklisted.AddressBz,
+		Height:    blacklisted.Height,
+	}
+}
@@ -24,6 +24,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Blacklisted struct {
 	AddressBz []byte `protobuf:"bytes,1,opt,name=addressBz,proto3" json:"addressBz,omitempty"`
+	// height is the block height the address was blacklisted at, or zero if it
+	// was blacklisted before heights were recorded.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
 func (m *Blacklisted) Reset()         { *m = Blacklisted{} }
@@ -66,25 +69,98 @@ func (m *Blacklisted) GetAddressBz() []byte {
 	return nil
 }
 
+func (m *Blacklisted) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// BlacklistedAddress is a blacklisted address as returned by queries, both
+// as bech32 under the chain prefix and as raw bytes.
+type BlacklistedAddress struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	AddressBz []byte `protobuf:"bytes,2,opt,name=address_bz,json=addressBz,proto3" json:"address_bz,omitempty"`
+	Height    int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *BlacklistedAddress) Reset()         { *m = BlacklistedAddress{} }
+func (m *BlacklistedAddress) String() string { return proto.CompactTextString(m) }
+func (*BlacklistedAddress) ProtoMessage()    {}
+func (*BlacklistedAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43ff59c42df01ab4, []int{1}
+}
+func (m *BlacklistedAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlacklistedAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlacklistedAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlacklistedAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlacklistedAddress.Merge(m, src)
+}
+func (m *BlacklistedAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlacklistedAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlacklistedAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlacklistedAddress proto.InternalMessageInfo
+
+func (m *BlacklistedAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BlacklistedAddress) GetAddressBz() []byte {
+	if m != nil {
+		return m.AddressBz
+	}
+	return nil
+}
+
+func (m *BlacklistedAddress) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Blacklisted)(nil), "noble.tokenfactory.Blacklisted")
+	proto.RegisterType((*BlacklistedAddress)(nil), "noble.tokenfactory.BlacklistedAddress")
 }
 
 func init() { proto.RegisterFile("tokenfactory/blacklisted.proto", fileDescriptor_43ff59c42df01ab4) }
 
 var fileDescriptor_43ff59c42df01ab4 = []byte{
-	// 165 bytes of a gzipped FileDescriptorProto
+	// 219 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0xc9, 0xcf, 0x4e,
 	0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x4f, 0xca, 0x49, 0x4c, 0xce, 0xce, 0xc9,
 	0x2c, 0x2e, 0x49, 0x4d, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca, 0xcb, 0x4f, 0xca,
-	0x49, 0xd5, 0x43, 0x56, 0xa5, 0xa4, 0xcd, 0xc5, 0xed, 0x84, 0x50, 0x28, 0x24, 0xc3, 0xc5, 0x99,
+	0x49, 0xd5, 0x43, 0x56, 0xa5, 0xe4, 0xcc, 0xc5, 0xed, 0x84, 0x50, 0x28, 0x24, 0xc3, 0xc5, 0x99,
 	0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c, 0xec, 0x54, 0x25, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x13, 0x84,
-	0x10, 0x70, 0xf2, 0x3f, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18,
-	0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xd3, 0xf4,
-	0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xb0, 0x2d, 0xba, 0x89, 0xc5, 0xc5,
-	0xa9, 0x25, 0xc5, 0x10, 0x8e, 0x7e, 0x99, 0xa9, 0x7e, 0x85, 0x3e, 0x8a, 0xeb, 0x4a, 0x2a, 0x0b,
-	0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x0e, 0x33, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x18, 0x40, 0xfc,
-	0x3c, 0xba, 0x00, 0x00, 0x00,
+	0x10, 0x10, 0x12, 0xe3, 0x62, 0xcb, 0x48, 0xcd, 0x4c, 0xcf, 0x28, 0x91, 0x60, 0x52, 0x60, 0xd4,
+	0x60, 0x0e, 0x82, 0xf2, 0x94, 0x52, 0xb9, 0x84, 0x90, 0x0c, 0x71, 0x84, 0xa8, 0x17, 0x92, 0xe0,
+	0x62, 0x87, 0x6a, 0x05, 0x9b, 0xc4, 0x19, 0x04, 0xe3, 0x0a, 0xc9, 0x72, 0x71, 0x41, 0x99, 0xf1,
+	0x49, 0x55, 0x12, 0x4c, 0xb8, 0xad, 0x61, 0x46, 0xb6, 0xc6, 0xc9, 0xff, 0xc4, 0x23, 0x39, 0xc6,
+	0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39,
+	0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x4c, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3,
+	0x73, 0xf5, 0xc1, 0x9e, 0xd4, 0x4d, 0x2c, 0x2e, 0x4e, 0x2d, 0x29, 0x86, 0x70, 0xf4, 0xcb, 0x4c,
+	0xf5, 0x2b, 0xf4, 0x51, 0x02, 0xa7, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x2e, 0xc6,
+	0x80, 0x01, 0x00, 0xa6, 0xa8, 0x8d, 0xed, 0x39, 0x01, 0x00, 0x00,
 }
 
 func (m *Blacklisted) Marshal() (dAtA []byte, err error) {
@@ -107,6 +183,11 @@ func (m *Blacklisted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Height != 0 {
+		i = encodeVarintBlacklisted(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.AddressBz) > 0 {
 		i -= len(m.AddressBz)
 		copy(dAtA[i:], m.AddressBz)
@@ -117,6 +198,48 @@ func (m *Blacklisted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlacklistedAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlacklistedAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlacklistedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintBlacklisted(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AddressBz) > 0 {
+		i -= len(m.AddressBz)
+		copy(dAtA[i:], m.AddressBz)
+		i = encodeVarintBlacklisted(dAtA, i, uint64(len(m.AddressBz)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBlacklisted(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBlacklisted(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBlacklisted(v)
 	base := offset
@@ -138,6 +261,29 @@ func (m *Blacklisted) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBlacklisted(uint64(l))
 	}
+	if m.Height != 0 {
+		n += 1 + sovBlacklisted(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *BlacklistedAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBlacklisted(uint64(l))
+	}
+	l = len(m.AddressBz)
+	if l > 0 {
+		n += 1 + l + sovBlacklisted(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBlacklisted(uint64(m.Height))
+	}
 	return n
 }
 
@@ -210,6 +356,160 @@ func (m *Blacklisted) Unmarshal(dAtA []byte) error {
 				m.AddressBz = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlacklisted(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlacklistedAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlacklisted
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlacklistedAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlacklistedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressBz", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressBz = append(m.AddressBz[:0], dAtA[iNdEx:postIndex]...)
+			if m.AddressBz == nil {
+				m.AddressBz = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlacklisted(dAtA[iNdEx:])
@@ -27,6 +27,21 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUnpause{}, "tokenfactory/Unpause", nil)
 	cdc.RegisterConcrete(&MsgConfigureMinterController{}, "tokenfactory/ConfigureMinterController", nil)
 	cdc.RegisterConcrete(&MsgRemoveMinterController{}, "tokenfactory/RemoveMinterController", nil)
+	cdc.RegisterConcrete(&MsgIncreaseMinterAllowance{}, "tokenfactory/IncreaseMinterAllowance", nil)
+	cdc.RegisterConcrete(&MsgDecreaseMinterAllowance{}, "tokenfactory/DecreaseMinterAllowance", nil)
+	cdc.RegisterConcrete(&MsgCreateDenom{}, "tokenfactory/CreateDenom", nil)
+	cdc.RegisterConcrete(&MsgMintFactoryDenom{}, "tokenfactory/MintFactoryDenom", nil)
+	cdc.RegisterConcrete(&MsgBurnFactoryDenom{}, "tokenfactory/BurnFactoryDenom", nil)
+	cdc.RegisterConcrete(&MsgChangeFactoryDenomAdmin{}, "tokenfactory/ChangeFactoryDenomAdmin", nil)
+	cdc.RegisterConcrete(&MsgSetDenomMetadata{}, "tokenfactory/SetDenomMetadata", nil)
+	cdc.RegisterConcrete(&MsgUpdateAttestor{}, "tokenfactory/UpdateAttestor", nil)
+	cdc.RegisterConcrete(&MsgSubmitAttestation{}, "tokenfactory/SubmitAttestation", nil)
+	cdc.RegisterConcrete(&MsgApproveMint{}, "tokenfactory/ApproveMint", nil)
+	cdc.RegisterConcrete(&MsgRejectMint{}, "tokenfactory/RejectMint", nil)
+	cdc.RegisterConcrete(&MsgRequestRedemption{}, "tokenfactory/RequestRedemption", nil)
+	cdc.RegisterConcrete(&MsgFinalizeRedemption{}, "tokenfactory/FinalizeRedemption", nil)
+	cdc.RegisterConcrete(&MsgCancelRedemption{}, "tokenfactory/CancelRedemption", nil)
+	cdc.RegisterConcrete(&MsgRegisterMintingDenom{}, "tokenfactory/RegisterMintingDenom", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -46,6 +61,21 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUnpause{},
 		&MsgConfigureMinterController{},
 		&MsgRemoveMinterController{},
+		&MsgIncreaseMinterAllowance{},
+		&MsgDecreaseMinterAllowance{},
+		&MsgCreateDenom{},
+		&MsgMintFactoryDenom{},
+		&MsgBurnFactoryDenom{},
+		&MsgChangeFactoryDenomAdmin{},
+		&MsgSetDenomMetadata{},
+		&MsgUpdateAttestor{},
+		&MsgSubmitAttestation{},
+		&MsgApproveMint{},
+		&MsgRejectMint{},
+		&MsgRequestRedemption{},
+		&MsgFinalizeRedemption{},
+		&MsgCancelRedemption{},
+		&MsgRegisterMintingDenom{},
 	)
 
 	// this line is used by starport scaffolding # 3
@@ -18,4 +18,17 @@ var (
 	ErrUserBlacklisted    = sdkerrors.Register(ModuleName, 10, "user is already blacklisted")
 	ErrAlreadyPrivileged  = sdkerrors.Register(ModuleName, 11, "address is already assigned to privileged role")
 	ErrDenomNotRegistered = sdkerrors.Register(ModuleName, 12, "denom not registered in bank module")
+	ErrTravelRule         = sdkerrors.Register(ModuleName, 13, "travel rule memo required")
+	ErrAllowanceMismatch  = sdkerrors.Register(ModuleName, 14, "minter allowance is not the expected one")
+	ErrAllowanceCap       = sdkerrors.Register(ModuleName, 15, "minter allowance exceeds the cap of the minter controller")
+	ErrControllerExists   = sdkerrors.Register(ModuleName, 16, "minter controller is already configured")
+	ErrInvalidDenom       = sdkerrors.Register(ModuleName, 17, "invalid factory denom")
+	ErrDenomExists        = sdkerrors.Register(ModuleName, 18, "denom already exists")
+	ErrNotMintingDenom    = sdkerrors.Register(ModuleName, 19, "not a minting denom")
+	ErrInvalidAttestation = sdkerrors.Register(ModuleName, 20, "invalid attestation")
+	ErrPendingMintExpired = sdkerrors.Register(ModuleName, 21, "pending mint has expired")
+	ErrPendingMintUnknown = sdkerrors.Register(ModuleName, 22, "pending mint not found")
+	ErrInvalidRedemption  = sdkerrors.Register(ModuleName, 23, "invalid redemption")
+	ErrRedemptionUnknown  = sdkerrors.Register(ModuleName, 24, "redemption not found")
+	ErrMaxMinters         = sdkerrors.Register(ModuleName, 25, "max number of minters reached")
 )
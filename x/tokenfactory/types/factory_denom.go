@@ -0,0 +1,62 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// FactoryDenomPrefix is the first part of every factory denom.
+	FactoryDenomPrefix = "factory"
+	// MaxSubdenomLength is the max length of the subdenom of a factory denom.
+	MaxSubdenomLength = 44
+)
+
+// GetFactoryDenom returns the factory/{creator}/{subdenom} denom, or an error
+// if it is not a valid denom.
+func GetFactoryDenom(creator, subdenom string) (string, error) {
+	if subdenom == "" {
+		return "", sdkerrors.Wrap(ErrInvalidDenom, "subdenom can not be empty")
+	}
+	if len(subdenom) > MaxSubdenomLength {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "subdenom is longer than %d characters", MaxSubdenomLength)
+	}
+	if strings.Contains(subdenom, "/") {
+		return "", sdkerrors.Wrap(ErrInvalidDenom, "subdenom can not contain a slash")
+	}
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "invalid creator address (%s)", err)
+	}
+
+	denom := strings.Join([]string{FactoryDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+	return denom, nil
+}
+
+// DeconstructFactoryDenom returns the creator and subdenom of a factory
+// denom, or an error if denom is not one.
+func DeconstructFactoryDenom(denom string) (creator string, subdenom string, err error) {
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) != 3 || parts[0] != FactoryDenomPrefix {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenom, "%s is not of the form %s/{creator}/{subdenom}", denom, FactoryDenomPrefix)
+	}
+	if _, err := GetFactoryDenom(parts[1], parts[2]); err != nil {
+		return "", "", err
+	}
+	return parts[1], parts[2], nil
+}
+
+// IsFactoryDenom returns true if denom is in the namespace of factory denoms.
+func IsFactoryDenom(denom string) bool {
+	return strings.HasPrefix(denom, FactoryDenomPrefix+"/")
+}
+
+// FactoryDenomCreatorPrefix returns the common prefix of the factory denoms
+// of creator.
+func FactoryDenomCreatorPrefix(creator string) []byte {
+	return []byte(FactoryDenomPrefix + "/" + creator + "/")
+}
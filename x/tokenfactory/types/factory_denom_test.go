@@ -0,0 +1,36 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFactoryDenom(t *testing.T) {
+	creator := sample.AccAddress()
+
+	denom, err := GetFactoryDenom(creator, "ufoo")
+	require.NoError(t, err)
+	require.Equal(t, "factory/"+creator+"/ufoo", denom)
+	require.True(t, IsFactoryDenom(denom))
+
+	gotCreator, gotSubdenom, err := DeconstructFactoryDenom(denom)
+	require.NoError(t, err)
+	require.Equal(t, creator, gotCreator)
+	require.Equal(t, "ufoo", gotSubdenom)
+
+	for _, subdenom := range []string{"", "u/foo", strings.Repeat("a", MaxSubdenomLength+1), "u foo"} {
+		_, err := GetFactoryDenom(creator, subdenom)
+		require.ErrorIs(t, err, ErrInvalidDenom, subdenom)
+	}
+	_, err = GetFactoryDenom("invalid_address", "ufoo")
+	require.ErrorIs(t, err, ErrInvalidDenom)
+
+	for _, denom := range []string{"utoken", "factory/" + creator, "factory/" + creator + "/", "other/" + creator + "/ufoo"} {
+		_, _, err := DeconstructFactoryDenom(denom)
+		require.ErrorIs(t, err, ErrInvalidDenom, denom)
+	}
+	require.False(t, IsFactoryDenom("utoken"))
+}
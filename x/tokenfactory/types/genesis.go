@@ -19,6 +19,7 @@ func DefaultGenesis() *GenesisState {
 		Owner:                nil,
 		MinterControllerList: []MinterController{},
 		MintingDenom:         nil,
+		FactoryDenoms:        []FactoryDenom{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -27,19 +28,119 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	// Check for duplicated index in blacklisted
+	legacy := gs.legacyDenomState()
+	if gs.MintingDenom == nil && !legacy.isEmpty() {
+		return fmt.Errorf("tokenfactory state requires a minting denom")
+	}
+	if gs.MintingDenom != nil && gs.MintingDenom.Denom == "" {
+		return fmt.Errorf("minting denom cannot be an empty string")
+	}
+	if gs.MintingDenom != nil && IsFactoryDenom(gs.MintingDenom.Denom) {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "minting denom %s is in the namespace of factory denoms", gs.MintingDenom.Denom)
+	}
+	if err := legacy.validate(); err != nil {
+		return err
+	}
+
+	denomIndexMap := make(map[string]struct{})
+	if gs.MintingDenom != nil {
+		denomIndexMap[gs.MintingDenom.Denom] = struct{}{}
+	}
+	for _, elem := range gs.Denoms {
+		if err := sdk.ValidateDenom(elem.MintingDenom.Denom); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid minting denom (%s)", err)
+		}
+		if IsFactoryDenom(elem.MintingDenom.Denom) {
+			return sdkerrors.Wrapf(ErrInvalidDenom, "minting denom %s is in the namespace of factory denoms", elem.MintingDenom.Denom)
+		}
+		if _, ok := denomIndexMap[elem.MintingDenom.Denom]; ok {
+			return fmt.Errorf("duplicated minting denom %s", elem.MintingDenom.Denom)
+		}
+		denomIndexMap[elem.MintingDenom.Denom] = struct{}{}
+
+		if err := elem.validate(); err != nil {
+			return sdkerrors.Wrapf(err, "minting denom %s", elem.MintingDenom.Denom)
+		}
+	}
+
+	// Check for duplicated factory denoms and validate their admin
+	factoryDenomIndexMap := make(map[string]struct{})
+	for _, elem := range gs.FactoryDenoms {
+		if _, _, err := DeconstructFactoryDenom(elem.Denom); err != nil {
+			return err
+		}
+		if _, ok := factoryDenomIndexMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated factory denom %s", elem.Denom)
+		}
+		factoryDenomIndexMap[elem.Denom] = struct{}{}
+
+		if elem.Admin != "" {
+			if _, err := sdk.AccAddressFromBech32(elem.Admin); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "factory denom %s has invalid admin address (%s)", elem.Denom, err)
+			}
+		}
+	}
+
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
+
+// DenomStates returns the state of every minting denom in the genesis, the
+// single denom fields first if their minting denom is set.
+func (gs GenesisState) DenomStates() []DenomGenesisState {
+	var states []DenomGenesisState
+	if gs.MintingDenom != nil {
+		states = append(states, gs.legacyDenomState())
+	}
+	return append(states, gs.Denoms...)
+}
+
+// legacyDenomState returns the single denom fields of the genesis.
+func (gs GenesisState) legacyDenomState() DenomGenesisState {
+	state := DenomGenesisState{
+		BlacklistedList:      gs.BlacklistedList,
+		Paused:               gs.Paused,
+		MasterMinter:         gs.MasterMinter,
+		MintersList:          gs.MintersList,
+		Pauser:               gs.Pauser,
+		Blacklister:          gs.Blacklister,
+		Owner:                gs.Owner,
+		MinterControllerList: gs.MinterControllerList,
+	}
+	if gs.MintingDenom != nil {
+		state.MintingDenom = *gs.MintingDenom
+	}
+	return state
+}
+
+// isEmpty returns true if no state but the minting denom is set.
+func (ds DenomGenesisState) isEmpty() bool {
+	return len(ds.BlacklistedList) == 0 && ds.Paused == nil && ds.MasterMinter == nil &&
+		len(ds.MintersList) == 0 && ds.Pauser == nil && ds.Blacklister == nil &&
+		ds.Owner == nil && len(ds.MinterControllerList) == 0
+}
+
+func (ds DenomGenesisState) validate() error {
+	denom := ds.MintingDenom.Denom
+
+	// Check for duplicated index in blacklisted and validate the addresses
 	blacklistedIndexMap := make(map[string]struct{})
-	for _, elem := range gs.BlacklistedList {
+	for _, elem := range ds.BlacklistedList {
 		index := string(BlacklistedKey(elem.AddressBz))
 		if _, ok := blacklistedIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for blacklisted")
 		}
 		blacklistedIndexMap[index] = struct{}{}
+
+		if err := sdk.VerifyAddressFormat(elem.AddressBz); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid blacklisted address (%s)", err)
+		}
 	}
 
 	// Check for duplicated index in minters and validate minter addr and allowance
 	mintersIndexMap := make(map[string]struct{})
-	for _, elem := range gs.MintersList {
+	for _, elem := range ds.MintersList {
 		index := string(MintersKey(elem.Address))
 		if _, ok := mintersIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for minters")
@@ -53,12 +154,16 @@ func (gs GenesisState) Validate() error {
 		if elem.Allowance.IsNil() || elem.Allowance.IsNegative() {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "minter allowance cannot be nil or negative")
 		}
+
+		if elem.Allowance.Denom != denom {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "minter %s has an allowance in %s instead of the minting denom", elem.Address, elem.Allowance.Denom)
+		}
 	}
 
 	// Check for duplicated index in minterController and validate both controller and minter addresses
 	minterControllerIndexMap := make(map[string]struct{})
-	for _, elem := range gs.MinterControllerList {
-		index := string(MinterControllerKey(elem.Controller))
+	for _, elem := range ds.MinterControllerList {
+		index := string(MinterControllerKey(elem.Controller, elem.Minter))
 		if _, ok := minterControllerIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for minterController")
 		}
@@ -71,65 +176,157 @@ func (gs GenesisState) Validate() error {
 		if _, err := sdk.AccAddressFromBech32(elem.Controller); err != nil {
 			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "minter controller has invalid controller address (%s)", err)
 		}
-	}
 
-	var addresses []sdk.AccAddress
+		if elem.Controller == elem.Minter {
+			return sdkerrors.Wrapf(ErrUnauthorized, "minter %s can not be its own controller", elem.Minter)
+		}
 
-	if gs.Owner != nil {
-		owner, err := sdk.AccAddressFromBech32(gs.Owner.Address)
-		if err != nil {
-			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+		if _, ok := mintersIndexMap[string(MintersKey(elem.Minter))]; !ok {
+			return sdkerrors.Wrapf(ErrUserNotFound, "minter controller %s refers to unknown minter %s", elem.Controller, elem.Minter)
+		}
+
+		if elem.AllowanceCap != nil && (elem.AllowanceCap.IsNil() || !elem.AllowanceCap.IsValid()) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "minter controller has invalid allowance cap (%s)", elem.AllowanceCap)
+		}
+
+		if elem.AllowanceCap != nil && elem.AllowanceCap.Denom != denom {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "minter controller %s has an allowance cap in %s instead of the minting denom", elem.Controller, elem.AllowanceCap.Denom)
+		}
+
+		if elem.Granted != nil && (elem.Granted.IsNil() || !elem.Granted.IsValid() || elem.Granted.Denom != denom) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "minter controller %s has an invalid granted allowance (%s)", elem.Controller, elem.Granted)
 		}
-		addresses = append(addresses, owner)
 	}
 
-	if gs.MasterMinter != nil {
-		masterMinter, err := sdk.AccAddressFromBech32(gs.MasterMinter.Address)
-		if err != nil {
-			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid master minter address (%s)", err)
+	// Check for duplicated ids in attestations and validate their reports
+	attestationIndexMap := make(map[uint64]struct{})
+	for _, elem := range ds.Attestations {
+		if _, ok := attestationIndexMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id %d for attestations", elem.Id)
+		}
+		attestationIndexMap[elem.Id] = struct{}{}
+
+		if _, err := sdk.AccAddressFromBech32(elem.Attestor); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "attestation %d has invalid attestor address (%s)", elem.Id, err)
+		}
+
+		if elem.Reserves.IsNil() || !elem.Reserves.IsValid() || elem.Reserves.Denom != denom {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "attestation %d has invalid reserves (%s)", elem.Id, elem.Reserves)
+		}
+
+		if err := validateAttestationReport(elem.Timestamp, elem.Uri, elem.Hash); err != nil {
+			return sdkerrors.Wrapf(err, "attestation %d", elem.Id)
 		}
-		addresses = append(addresses, masterMinter)
 	}
 
-	if gs.Pauser != nil {
-		pauser, err := sdk.AccAddressFromBech32(gs.Pauser.Address)
-		if err != nil {
-			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid pauser address (%s)", err)
+	// Check for duplicated ids in pending mints and validate their mints
+	pendingMintIndexMap := make(map[uint64]struct{})
+	for _, elem := range ds.PendingMints {
+		if _, ok := pendingMintIndexMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id %d for pending mints", elem.Id)
+		}
+		pendingMintIndexMap[elem.Id] = struct{}{}
+
+		if elem.Id >= ds.PendingMintCount {
+			return fmt.Errorf("pending mint id %d is not below the pending mint count %d", elem.Id, ds.PendingMintCount)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(elem.Minter); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "pending mint %d has invalid minter address (%s)", elem.Id, err)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "pending mint %d has invalid recipient address (%s)", elem.Id, err)
+		}
+
+		if elem.Amount.IsNil() || !elem.Amount.IsPositive() || elem.Amount.Denom != denom {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "pending mint %d has invalid amount (%s)", elem.Id, elem.Amount)
 		}
-		addresses = append(addresses, pauser)
 	}
 
-	if gs.Blacklister != nil {
-		blacklister, err := sdk.AccAddressFromBech32(gs.Blacklister.Address)
-		if err != nil {
-			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid black lister address (%s)", err)
+	// Check for duplicated ids in redemptions and validate their requests
+	redemptionIndexMap := make(map[uint64]struct{})
+	for _, elem := range ds.Redemptions {
+		if _, ok := redemptionIndexMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id %d for redemptions", elem.Id)
+		}
+		redemptionIndexMap[elem.Id] = struct{}{}
+
+		if elem.Id >= ds.RedemptionCount {
+			return fmt.Errorf("redemption id %d is not below the redemption count %d", elem.Id, ds.RedemptionCount)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(elem.Redeemer); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "redemption %d has invalid redeemer address (%s)", elem.Id, err)
+		}
+
+		if elem.Amount.IsNil() || !elem.Amount.IsPositive() || elem.Amount.Denom != denom {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "redemption %d has invalid amount (%s)", elem.Id, elem.Amount)
+		}
+
+		if err := validateBankReference(elem.BankReference); err != nil {
+			return sdkerrors.Wrapf(err, "redemption %d", elem.Id)
+		}
+
+		switch elem.Status {
+		case REDEMPTION_STATUS_PENDING:
+		case REDEMPTION_STATUS_FINALIZED, REDEMPTION_STATUS_CANCELLED:
+			if _, err := sdk.AccAddressFromBech32(elem.Minter); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "redemption %d has invalid minter address (%s)", elem.Id, err)
+			}
+		default:
+			return sdkerrors.Wrapf(ErrInvalidRedemption, "redemption %d has invalid status %s", elem.Id, elem.Status)
 		}
-		addresses = append(addresses, blacklister)
 	}
 
-	if err := validatePrivileges(addresses); err != nil {
-		return err
+	var roles []privilegedRole
+
+	if ds.Owner != nil {
+		roles = append(roles, privilegedRole{name: "owner", address: ds.Owner.Address})
 	}
 
-	if gs.MintingDenom != nil && gs.MintingDenom.Denom == "" {
-		return fmt.Errorf("minting denom cannot be an empty string")
+	if ds.MasterMinter != nil {
+		roles = append(roles, privilegedRole{name: "master minter", address: ds.MasterMinter.Address})
 	}
 
-	// this line is used by starport scaffolding # genesis/types/validate
+	if ds.Pauser != nil {
+		roles = append(roles, privilegedRole{name: "pauser", address: ds.Pauser.Address})
+	}
 
-	return gs.Params.Validate()
+	if ds.Blacklister != nil {
+		roles = append(roles, privilegedRole{name: "black lister", address: ds.Blacklister.Address})
+	}
+
+	if ds.Attestor != nil {
+		roles = append(roles, privilegedRole{name: "attestor", address: ds.Attestor.Address})
+	}
+
+	for _, role := range roles {
+		address, err := sdk.AccAddressFromBech32(role.address)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid %s address (%s)", role.name, err)
+		}
+
+		if _, ok := blacklistedIndexMap[string(BlacklistedKey(address))]; ok {
+			return sdkerrors.Wrapf(ErrUnauthorized, "%s %s is blacklisted", role.name, role.address)
+		}
+	}
+
+	return validatePrivileges(roles)
 }
 
-// validatePrivileges ensures that the same address is not being assigned to more than one privileged role.
-func validatePrivileges(addresses []sdk.AccAddress) error {
-	for i, current := range addresses {
-		for j, target := range addresses {
-			if i == j {
-				continue
-			}
+// privilegedRole is a privileged role of a minting denom and its address.
+type privilegedRole struct {
+	name    string
+	address string
+}
 
-			if current.String() == target.String() {
-				return sdkerrors.Wrapf(ErrAlreadyPrivileged, "%s", current)
+// validatePrivileges ensures that the same address is not being assigned to more than one privileged role.
+func validatePrivileges(roles []privilegedRole) error {
+	for i, current := range roles {
+		for _, target := range roles[i+1:] {
+			if current.address == target.address {
+				return sdkerrors.Wrapf(ErrAlreadyPrivileged, "%s is assigned to both the %s and %s roles", current.address, current.name, target.name)
 			}
 		}
 	}
@@ -25,16 +25,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the tokenfactory module's genesis state.
 type GenesisState struct {
-	Params               Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	BlacklistedList      []Blacklisted      `protobuf:"bytes,2,rep,name=blacklistedList,proto3" json:"blacklistedList"`
-	Paused               *Paused            `protobuf:"bytes,3,opt,name=paused,proto3" json:"paused,omitempty"`
-	MasterMinter         *MasterMinter      `protobuf:"bytes,4,opt,name=masterMinter,proto3" json:"masterMinter,omitempty"`
-	MintersList          []Minters          `protobuf:"bytes,5,rep,name=mintersList,proto3" json:"mintersList"`
-	Pauser               *Pauser            `protobuf:"bytes,6,opt,name=pauser,proto3" json:"pauser,omitempty"`
-	Blacklister          *Blacklister       `protobuf:"bytes,7,opt,name=blacklister,proto3" json:"blacklister,omitempty"`
-	Owner                *Owner             `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
-	MinterControllerList []MinterController `protobuf:"bytes,9,rep,name=minterControllerList,proto3" json:"minterControllerList"`
-	MintingDenom         *MintingDenom      `protobuf:"bytes,10,opt,name=mintingDenom,proto3" json:"mintingDenom,omitempty"`
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// The fields up to mintingDenom hold the state of a single minting denom,
+	// as in genesis files predating denoms.
+	BlacklistedList      []Blacklisted       `protobuf:"bytes,2,rep,name=blacklistedList,proto3" json:"blacklistedList"`
+	Paused               *Paused             `protobuf:"bytes,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	MasterMinter         *MasterMinter       `protobuf:"bytes,4,opt,name=masterMinter,proto3" json:"masterMinter,omitempty"`
+	MintersList          []Minters           `protobuf:"bytes,5,rep,name=mintersList,proto3" json:"mintersList"`
+	Pauser               *Pauser             `protobuf:"bytes,6,opt,name=pauser,proto3" json:"pauser,omitempty"`
+	Blacklister          *Blacklister        `protobuf:"bytes,7,opt,name=blacklister,proto3" json:"blacklister,omitempty"`
+	Owner                *Owner              `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	MinterControllerList []MinterController  `protobuf:"bytes,9,rep,name=minterControllerList,proto3" json:"minterControllerList"`
+	MintingDenom         *MintingDenom       `protobuf:"bytes,10,opt,name=mintingDenom,proto3" json:"mintingDenom,omitempty"`
+	Denoms               []DenomGenesisState `protobuf:"bytes,11,rep,name=denoms,proto3" json:"denoms"`
+	FactoryDenoms        []FactoryDenom      `protobuf:"bytes,12,rep,name=factoryDenoms,proto3" json:"factoryDenoms"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -140,43 +144,229 @@ func (m *GenesisState) GetMintingDenom() *MintingDenom {
 	return nil
 }
 
+func (m *GenesisState) GetDenoms() []DenomGenesisState {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFactoryDenoms() []FactoryDenom {
+	if m != nil {
+		return m.FactoryDenoms
+	}
+	return nil
+}
+
+// DenomGenesisState defines the state of a minting denom.
+type DenomGenesisState struct {
+	MintingDenom         MintingDenom       `protobuf:"bytes,1,opt,name=mintingDenom,proto3" json:"mintingDenom"`
+	BlacklistedList      []Blacklisted      `protobuf:"bytes,2,rep,name=blacklistedList,proto3" json:"blacklistedList"`
+	Paused               *Paused            `protobuf:"bytes,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	MasterMinter         *MasterMinter      `protobuf:"bytes,4,opt,name=masterMinter,proto3" json:"masterMinter,omitempty"`
+	MintersList          []Minters          `protobuf:"bytes,5,rep,name=mintersList,proto3" json:"mintersList"`
+	Pauser               *Pauser            `protobuf:"bytes,6,opt,name=pauser,proto3" json:"pauser,omitempty"`
+	Blacklister          *Blacklister       `protobuf:"bytes,7,opt,name=blacklister,proto3" json:"blacklister,omitempty"`
+	Owner                *Owner             `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	MinterControllerList []MinterController `protobuf:"bytes,9,rep,name=minterControllerList,proto3" json:"minterControllerList"`
+	Attestor             *Attestor          `protobuf:"bytes,10,opt,name=attestor,proto3" json:"attestor,omitempty"`
+	Attestations         []Attestation      `protobuf:"bytes,11,rep,name=attestations,proto3" json:"attestations"`
+	PendingMints         []PendingMint      `protobuf:"bytes,12,rep,name=pendingMints,proto3" json:"pendingMints"`
+	// pendingMintCount is the id of the next pending mint.
+	PendingMintCount uint64       `protobuf:"varint,13,opt,name=pendingMintCount,proto3" json:"pendingMintCount,omitempty"`
+	Redemptions      []Redemption `protobuf:"bytes,14,rep,name=redemptions,proto3" json:"redemptions"`
+	// redemptionCount is the id of the next redemption.
+	RedemptionCount uint64 `protobuf:"varint,15,opt,name=redemptionCount,proto3" json:"redemptionCount,omitempty"`
+}
+
+func (m *DenomGenesisState) Reset()         { *m = DenomGenesisState{} }
+func (m *DenomGenesisState) String() string { return proto.CompactTextString(m) }
+func (*DenomGenesisState) ProtoMessage()    {}
+func (*DenomGenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_415d5acd9b7bd461, []int{1}
+}
+func (m *DenomGenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomGenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomGenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomGenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomGenesisState.Merge(m, src)
+}
+func (m *DenomGenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomGenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomGenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomGenesisState proto.InternalMessageInfo
+
+func (m *DenomGenesisState) GetMintingDenom() MintingDenom {
+	if m != nil {
+		return m.MintingDenom
+	}
+	return MintingDenom{}
+}
+
+func (m *DenomGenesisState) GetBlacklistedList() []Blacklisted {
+	if m != nil {
+		return m.BlacklistedList
+	}
+	return nil
+}
+
+func (m *DenomGenesisState) GetPaused() *Paused {
+	if m != nil {
+		return m.Paused
+	}
+	return nil
+}
+
+func (m *DenomGenesisState) GetMasterMinter() *MasterMinter {
+	if m != nil {
+		return m.MasterMinter
+	}
+	return nil
+}
+
+func (m *DenomGenesisState) GetMintersList() []Minters {
+	if m != nil {
+		return m.MintersList
+	}
+	return nil
+}
+
+func (m *DenomGenesisState) GetPauser() *Pauser {
+	if m != nil {
+		return m.Pauser
+	}
+	return nil
+}
+
+func (m *DenomGenesisState) GetBlacklister() *Blacklister {
+	if m != nil {
+		return m.Blacklister
+	}
+	return nil
+}
+
+func (m *DenomGenesisState) GetOwner() *Owner {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *DenomGenesisState) GetMinterControllerList() []MinterController {
+	if m != nil {
+		return m.MinterControllerList
+	}
+	return nil
+}
+
+func (m *DenomGenesisState) GetAttestor() *Attestor {
+	if m != nil {
+		return m.Attestor
+	}
+	return nil
+}
+
+func (m *DenomGenesisState) GetAttestations() []Attestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func (m *DenomGenesisState) GetPendingMints() []PendingMint {
+	if m != nil {
+		return m.PendingMints
+	}
+	return nil
+}
+
+func (m *DenomGenesisState) GetPendingMintCount() uint64 {
+	if m != nil {
+		return m.PendingMintCount
+	}
+	return 0
+}
+
+func (m *DenomGenesisState) GetRedemptions() []Redemption {
+	if m != nil {
+		return m.Redemptions
+	}
+	return nil
+}
+
+func (m *DenomGenesisState) GetRedemptionCount() uint64 {
+	if m != nil {
+		return m.RedemptionCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "noble.tokenfactory.GenesisState")
+	proto.RegisterType((*DenomGenesisState)(nil), "noble.tokenfactory.DenomGenesisState")
 }
 
 func init() { proto.RegisterFile("tokenfactory/genesis.proto", fileDescriptor_415d5acd9b7bd461) }
 
 var fileDescriptor_415d5acd9b7bd461 = []byte{
-	// 451 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x41, 0x8e, 0xd3, 0x30,
-	0x18, 0x85, 0x13, 0x66, 0x5a, 0xc0, 0x19, 0x09, 0xc9, 0x9a, 0x85, 0x27, 0x48, 0x99, 0x08, 0xcd,
-	0x62, 0x36, 0x24, 0x52, 0xd1, 0x48, 0x6c, 0x69, 0x2b, 0xb1, 0xa1, 0x2a, 0x0a, 0x3b, 0x16, 0x54,
-	0x49, 0x6a, 0x42, 0xd4, 0xc4, 0xae, 0x6c, 0x17, 0xe8, 0x2d, 0x38, 0x05, 0x67, 0xe9, 0xb2, 0x4b,
-	0x56, 0x08, 0xb5, 0x17, 0x41, 0xb1, 0xad, 0x34, 0x06, 0xb7, 0xdd, 0xcd, 0xe8, 0x7d, 0xef, 0xf5,
-	0x7f, 0xbf, 0xff, 0x00, 0x5f, 0xd0, 0x05, 0x26, 0x9f, 0xd3, 0x5c, 0x50, 0xb6, 0x8e, 0x0b, 0x4c,
-	0x30, 0x2f, 0x79, 0xb4, 0x64, 0x54, 0x50, 0x08, 0x09, 0xcd, 0x2a, 0x1c, 0x75, 0x09, 0xff, 0xba,
-	0xa0, 0x05, 0x95, 0x72, 0xdc, 0xfc, 0xa5, 0x48, 0x3f, 0x30, 0x52, 0xb2, 0x2a, 0xcd, 0x17, 0x55,
-	0xc9, 0x05, 0x9e, 0x9f, 0xd1, 0x99, 0xd6, 0x43, 0x43, 0xaf, 0xd3, 0x46, 0x9a, 0xd5, 0x25, 0x39,
-	0x10, 0x77, 0x26, 0x21, 0xa5, 0x59, 0x4e, 0x89, 0x60, 0xb4, 0xaa, 0x5a, 0xca, 0xb7, 0x50, 0xdc,
-	0xfe, 0x1b, 0x25, 0x11, 0x25, 0x29, 0x66, 0x73, 0x4c, 0x68, 0xad, 0x09, 0x64, 0x10, 0xf4, 0x1b,
-	0x69, 0x73, 0x6f, 0x0c, 0x65, 0x99, 0xb2, 0xb4, 0xe6, 0x47, 0xa4, 0x15, 0x6f, 0x5b, 0x5b, 0x24,
-	0x1d, 0xf8, 0xe2, 0x67, 0x0f, 0x5c, 0xbd, 0x55, 0xcb, 0xfe, 0x20, 0x52, 0x81, 0xe1, 0x6b, 0xd0,
-	0x57, 0xb1, 0xc8, 0x0d, 0xdd, 0x7b, 0x6f, 0xe0, 0x47, 0xff, 0x2f, 0x3f, 0x7a, 0x2f, 0x89, 0xe1,
-	0xe5, 0xe6, 0xf7, 0xad, 0x93, 0x68, 0x1e, 0x4e, 0xc1, 0xb3, 0xce, 0xc2, 0xdf, 0x95, 0x5c, 0xa0,
-	0x47, 0xe1, 0xc5, 0xbd, 0x37, 0xb8, 0xb5, 0x45, 0x0c, 0x0f, 0xa8, 0xce, 0xf9, 0xd7, 0x0d, 0x07,
-	0xcd, 0x28, 0x4d, 0x0d, 0x74, 0x71, 0x6a, 0x94, 0x86, 0x48, 0x34, 0x09, 0xc7, 0xe0, 0x4a, 0xbd,
-	0xda, 0x44, 0xee, 0x1c, 0x5d, 0x4a, 0x67, 0x68, 0x73, 0x4e, 0x3a, 0x5c, 0x62, 0xb8, 0xe0, 0x08,
-	0x78, 0xfa, 0xcd, 0x64, 0x8d, 0x9e, 0xac, 0xf1, 0xdc, 0x1a, 0xa2, 0x30, 0x5d, 0xa1, 0xeb, 0x6a,
-	0xc7, 0x67, 0xa8, 0x7f, 0x66, 0x7c, 0xa6, 0xc7, 0x67, 0xf0, 0x0d, 0xf0, 0x3a, 0x47, 0x89, 0x1e,
-	0x4b, 0xe3, 0x99, 0xfd, 0xb1, 0xa4, 0xeb, 0x81, 0x31, 0xe8, 0xc9, 0x8b, 0x41, 0x4f, 0xa4, 0xf9,
-	0xc6, 0x66, 0x9e, 0x36, 0x40, 0xa2, 0x38, 0xf8, 0x09, 0x5c, 0xab, 0xb1, 0x47, 0xed, 0x15, 0xcb,
-	0xd6, 0x4f, 0x65, 0xeb, 0xbb, 0xe3, 0xad, 0x0f, 0xbc, 0xae, 0x6f, 0xcd, 0x91, 0x4f, 0xa2, 0x8e,
-	0x7c, 0xdc, 0xdc, 0x38, 0x02, 0x27, 0x9e, 0xa4, 0xc3, 0x25, 0x86, 0x6b, 0x38, 0xdd, 0xec, 0x02,
-	0x77, 0xbb, 0x0b, 0xdc, 0x3f, 0xbb, 0xc0, 0xfd, 0xb1, 0x0f, 0x9c, 0xed, 0x3e, 0x70, 0x7e, 0xed,
-	0x03, 0xe7, 0xe3, 0x43, 0x51, 0x8a, 0x2f, 0xab, 0x2c, 0xca, 0x69, 0x1d, 0xcb, 0xcc, 0x97, 0x29,
-	0xe7, 0x58, 0x70, 0xf5, 0x4f, 0xfc, 0xf5, 0x21, 0xfe, 0x1e, 0x1b, 0x1f, 0x80, 0x58, 0x2f, 0x31,
-	0xcf, 0xfa, 0xf2, 0x03, 0x78, 0xf5, 0x37, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x1f, 0x15, 0x34, 0x79,
-	0x04, 0x00, 0x00,
+	// 656 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x95, 0x41, 0x4f, 0xdb, 0x30,
+	0x14, 0xc7, 0x9b, 0x01, 0x81, 0x39, 0x65, 0x6c, 0x16, 0x07, 0x53, 0xb6, 0x34, 0x42, 0x4c, 0xaa,
+	0x26, 0xad, 0x91, 0x98, 0x90, 0xb8, 0x42, 0x11, 0xd3, 0x26, 0x10, 0x28, 0xbb, 0xed, 0x30, 0x94,
+	0xb6, 0x5e, 0x17, 0xd1, 0xd8, 0x55, 0x6c, 0xb6, 0xf1, 0x2d, 0xf6, 0x75, 0xf6, 0x0d, 0x38, 0x72,
+	0xdc, 0x69, 0x9a, 0x5a, 0xed, 0x7b, 0x4c, 0xb1, 0x9d, 0xc4, 0x6e, 0x5d, 0xba, 0x0f, 0xc0, 0xa9,
+	0x4d, 0xde, 0xef, 0xff, 0x7f, 0xef, 0xc5, 0xef, 0xc9, 0xa0, 0xc1, 0xe9, 0x15, 0x26, 0x9f, 0xe3,
+	0x1e, 0xa7, 0xd9, 0x4d, 0x38, 0xc0, 0x04, 0xb3, 0x84, 0xb5, 0x47, 0x19, 0xe5, 0x14, 0x42, 0x42,
+	0xbb, 0x43, 0xdc, 0xd6, 0x89, 0xc6, 0xe6, 0x80, 0x0e, 0xa8, 0x08, 0x87, 0xf9, 0x3f, 0x49, 0x36,
+	0x7c, 0xc3, 0x25, 0xe6, 0x1c, 0x33, 0x1e, 0xf3, 0x84, 0x12, 0x15, 0xdf, 0xb6, 0xc4, 0x69, 0x66,
+	0x15, 0x77, 0x87, 0x71, 0xef, 0x6a, 0x98, 0x30, 0x8e, 0xfb, 0x0b, 0xe2, 0x85, 0x3e, 0x30, 0xe2,
+	0xea, 0xf7, 0xb2, 0x8f, 0x09, 0x4d, 0xad, 0x44, 0x1a, 0xe7, 0xe2, 0xcb, 0x34, 0x21, 0x95, 0xc7,
+	0xae, 0x49, 0x88, 0xd0, 0x65, 0x8f, 0x12, 0x9e, 0xd1, 0xe1, 0xb0, 0xa4, 0x1a, 0x16, 0x8a, 0xd9,
+	0x73, 0x24, 0x84, 0x27, 0x64, 0x60, 0x54, 0x81, 0x0c, 0x82, 0x7e, 0x23, 0xa5, 0xef, 0x96, 0x11,
+	0x19, 0xc5, 0x59, 0x9c, 0xb2, 0x39, 0xa1, 0x6b, 0x56, 0x7e, 0x97, 0xa6, 0x19, 0xc2, 0xa4, 0x9f,
+	0x67, 0xcc, 0x33, 0x2b, 0xe0, 0x85, 0x01, 0x64, 0xb8, 0x8f, 0xd3, 0x91, 0x76, 0x28, 0x16, 0x6b,
+	0x55, 0xd0, 0xce, 0x4f, 0x17, 0xd4, 0xdf, 0xca, 0x59, 0xf8, 0xc0, 0x63, 0x8e, 0xe1, 0x01, 0x70,
+	0x65, 0x59, 0xc8, 0x09, 0x9c, 0x96, 0xb7, 0xd7, 0x68, 0xcf, 0xce, 0x46, 0xfb, 0x42, 0x10, 0x47,
+	0xcb, 0xb7, 0xbf, 0x9b, 0xb5, 0x48, 0xf1, 0xf0, 0x1c, 0x6c, 0x68, 0x47, 0x7a, 0x9a, 0x30, 0x8e,
+	0x1e, 0x05, 0x4b, 0x2d, 0x6f, 0xaf, 0x69, 0xb3, 0x38, 0xaa, 0x50, 0xe5, 0x33, 0xad, 0x86, 0x7b,
+	0xc0, 0x95, 0x9f, 0x01, 0x2d, 0xdd, 0x57, 0x4a, 0x4e, 0x44, 0x8a, 0x84, 0xc7, 0xa0, 0x2e, 0x4f,
+	0xfd, 0x4c, 0x9c, 0x19, 0x5a, 0x16, 0xca, 0xc0, 0xa6, 0x3c, 0xd3, 0xb8, 0xc8, 0x50, 0xc1, 0x0e,
+	0xf0, 0xd4, 0x99, 0x8b, 0x36, 0x56, 0x44, 0x1b, 0xdb, 0x56, 0x13, 0x89, 0xa9, 0x16, 0x74, 0x55,
+	0x59, 0x7e, 0x86, 0xdc, 0x05, 0xe5, 0x67, 0xaa, 0xfc, 0x0c, 0x1e, 0x02, 0x4f, 0x1b, 0x7b, 0xb4,
+	0x1a, 0x38, 0x8b, 0xbf, 0x5f, 0x16, 0xe9, 0x1a, 0x18, 0x82, 0x15, 0x31, 0x71, 0x68, 0x4d, 0x88,
+	0xb7, 0x6c, 0xe2, 0xf3, 0x1c, 0x88, 0x24, 0x07, 0x3f, 0x81, 0x4d, 0x59, 0x76, 0xa7, 0xdc, 0x02,
+	0xd1, 0xf5, 0x63, 0xd1, 0xf5, 0xee, 0xfc, 0xae, 0x2b, 0x5e, 0xb5, 0x6f, 0xf5, 0x11, 0x47, 0x22,
+	0x97, 0xe4, 0x38, 0xdf, 0x11, 0x04, 0xee, 0x39, 0x12, 0x8d, 0x8b, 0x0c, 0x15, 0xec, 0x00, 0x57,
+	0xac, 0x18, 0x43, 0x9e, 0xa8, 0xeb, 0xa5, 0x4d, 0x2f, 0x50, 0x7d, 0x9c, 0x8b, 0x11, 0x95, 0x52,
+	0x78, 0x0a, 0xd6, 0x15, 0x7a, 0x2c, 0xbd, 0xea, 0xc1, 0xd2, 0xbc, 0x5a, 0x4e, 0x34, 0x50, 0xd9,
+	0x98, 0xe2, 0x9d, 0xbf, 0xab, 0xe0, 0xd9, 0x4c, 0x46, 0xf8, 0x7e, 0xaa, 0x5d, 0xe7, 0xff, 0xda,
+	0x55, 0x29, 0xcc, 0xa6, 0x1f, 0x56, 0xea, 0x61, 0xa5, 0x6c, 0x2b, 0x75, 0x00, 0xd6, 0x8a, 0xab,
+	0x55, 0xad, 0xd3, 0x73, 0x9b, 0xe7, 0xa1, 0x62, 0xa2, 0x92, 0x86, 0xef, 0x40, 0x5d, 0xbb, 0xb4,
+	0x8b, 0x65, 0x6a, 0xce, 0x57, 0x0b, 0xae, 0x18, 0x4e, 0x5d, 0x9a, 0x5b, 0xa9, 0xab, 0x28, 0xaf,
+	0xbd, 0xd8, 0x25, 0xab, 0xd5, 0x45, 0xc5, 0x15, 0x56, 0xba, 0x14, 0xbe, 0x02, 0x4f, 0xb5, 0xe7,
+	0x0e, 0xbd, 0x26, 0x1c, 0xad, 0x07, 0x4e, 0x6b, 0x39, 0x9a, 0x79, 0x0f, 0x4f, 0x80, 0x57, 0x5d,
+	0x70, 0x0c, 0x3d, 0x11, 0x59, 0x7d, 0x5b, 0xd6, 0xa8, 0xc4, 0x8a, 0x59, 0xd2, 0x84, 0xb0, 0x05,
+	0x36, 0xaa, 0x47, 0x99, 0x72, 0x43, 0xa4, 0x9c, 0x7e, 0x7d, 0x74, 0x7e, 0x3b, 0xf6, 0x9d, 0xbb,
+	0xb1, 0xef, 0xfc, 0x19, 0xfb, 0xce, 0x8f, 0x89, 0x5f, 0xbb, 0x9b, 0xf8, 0xb5, 0x5f, 0x13, 0xbf,
+	0xf6, 0x71, 0x7f, 0x90, 0xf0, 0x2f, 0xd7, 0xdd, 0x76, 0x8f, 0xa6, 0xa1, 0x28, 0xe0, 0x75, 0xcc,
+	0x18, 0xe6, 0x4c, 0x3e, 0x84, 0x5f, 0xf7, 0xc3, 0xef, 0xa1, 0x71, 0xf7, 0xf2, 0x9b, 0x11, 0x66,
+	0x5d, 0x57, 0xdc, 0xbd, 0x6f, 0xfe, 0x0d, 0x00, 0x81, 0x64, 0x01, 0x28, 0x93, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -199,6 +389,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FactoryDenoms) > 0 {
+		for iNdEx := len(m.FactoryDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FactoryDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if m.MintingDenom != nil {
 		{
 			size, err := m.MintingDenom.MarshalToSizedBuffer(dAtA[:i])
@@ -281,122 +499,879 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			if err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Paused != nil {
-		{
-			size, err := m.Paused.MarshalToSizedBuffer(dAtA[:i])
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Paused != nil {
+		{
+			size, err := m.Paused.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlacklistedList) > 0 {
+		for iNdEx := len(m.BlacklistedList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlacklistedList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomGenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomGenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RedemptionCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RedemptionCount))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.Redemptions) > 0 {
+		for iNdEx := len(m.Redemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.PendingMintCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PendingMintCount))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.PendingMints) > 0 {
+		for iNdEx := len(m.PendingMints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingMints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.Attestor != nil {
+		{
+			size, err := m.Attestor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.MinterControllerList) > 0 {
+		for iNdEx := len(m.MinterControllerList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterControllerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Owner != nil {
+		{
+			size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Blacklister != nil {
+		{
+			size, err := m.Blacklister.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Pauser != nil {
+		{
+			size, err := m.Pauser.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MintersList) > 0 {
+		for iNdEx := len(m.MintersList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintersList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MasterMinter != nil {
+		{
+			size, err := m.MasterMinter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Paused != nil {
+		{
+			size, err := m.Paused.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlacklistedList) > 0 {
+		for iNdEx := len(m.BlacklistedList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlacklistedList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.MintingDenom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BlacklistedList) > 0 {
+		for _, e := range m.BlacklistedList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Paused != nil {
+		l = m.Paused.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MasterMinter != nil {
+		l = m.MasterMinter.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MintersList) > 0 {
+		for _, e := range m.MintersList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Pauser != nil {
+		l = m.Pauser.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Blacklister != nil {
+		l = m.Blacklister.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Owner != nil {
+		l = m.Owner.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MinterControllerList) > 0 {
+		for _, e := range m.MinterControllerList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MintingDenom != nil {
+		l = m.MintingDenom.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, e := range m.Denoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FactoryDenoms) > 0 {
+		for _, e := range m.FactoryDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomGenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintingDenom.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BlacklistedList) > 0 {
+		for _, e := range m.BlacklistedList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Paused != nil {
+		l = m.Paused.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MasterMinter != nil {
+		l = m.MasterMinter.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MintersList) > 0 {
+		for _, e := range m.MintersList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Pauser != nil {
+		l = m.Pauser.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Blacklister != nil {
+		l = m.Blacklister.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Owner != nil {
+		l = m.Owner.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MinterControllerList) > 0 {
+		for _, e := range m.MinterControllerList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Attestor != nil {
+		l = m.Attestor.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingMints) > 0 {
+		for _, e := range m.PendingMints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PendingMintCount != 0 {
+		n += 1 + sovGenesis(uint64(m.PendingMintCount))
+	}
+	if len(m.Redemptions) > 0 {
+		for _, e := range m.Redemptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RedemptionCount != 0 {
+		n += 1 + sovGenesis(uint64(m.RedemptionCount))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlacklistedList = append(m.BlacklistedList, Blacklisted{})
+			if err := m.BlacklistedList[len(m.BlacklistedList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Paused == nil {
+				m.Paused = &Paused{}
+			}
+			if err := m.Paused.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterMinter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MasterMinter == nil {
+				m.MasterMinter = &MasterMinter{}
+			}
+			if err := m.MasterMinter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintersList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintersList = append(m.MintersList, Minters{})
+			if err := m.MintersList[len(m.MintersList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pauser == nil {
+				m.Pauser = &Pauser{}
+			}
+			if err := m.Pauser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklister", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Blacklister == nil {
+				m.Blacklister = &Blacklister{}
+			}
+			if err := m.Blacklister.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Owner == nil {
+				m.Owner = &Owner{}
+			}
+			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterControllerList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterControllerList = append(m.MinterControllerList, MinterController{})
+			if err := m.MinterControllerList[len(m.MinterControllerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingDenom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintingDenom == nil {
+				m.MintingDenom = &MintingDenom{}
+			}
+			if err := m.MintingDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, DenomGenesisState{})
+			if err := m.Denoms[len(m.Denoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FactoryDenoms = append(m.FactoryDenoms, FactoryDenom{})
+			if err := m.FactoryDenoms[len(m.FactoryDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BlacklistedList) > 0 {
-		for iNdEx := len(m.BlacklistedList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BlacklistedList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.BlacklistedList) > 0 {
-		for _, e := range m.BlacklistedList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.Paused != nil {
-		l = m.Paused.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.MasterMinter != nil {
-		l = m.MasterMinter.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.MintersList) > 0 {
-		for _, e := range m.MintersList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.Pauser != nil {
-		l = m.Pauser.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.Blacklister != nil {
-		l = m.Blacklister.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.Owner != nil {
-		l = m.Owner.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.MinterControllerList) > 0 {
-		for _, e := range m.MinterControllerList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.MintingDenom != nil {
-		l = m.MintingDenom.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *DenomGenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -419,15 +1394,15 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomGenesisState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomGenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingDenom", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -454,7 +1429,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MintingDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -742,7 +1717,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintingDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -769,13 +1744,153 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MintingDenom == nil {
-				m.MintingDenom = &MintingDenom{}
+			if m.Attestor == nil {
+				m.Attestor = &Attestor{}
 			}
-			if err := m.MintingDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Attestor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingMints = append(m.PendingMints, PendingMint{})
+			if err := m.PendingMints[len(m.PendingMints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMintCount", wireType)
+			}
+			m.PendingMintCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingMintCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redemptions = append(m.Redemptions, Redemption{})
+			if err := m.Redemptions[len(m.Redemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionCount", wireType)
+			}
+			m.RedemptionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedemptionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/noble-assets/noble/v5/testutil/sample"
@@ -13,6 +14,17 @@ import (
 var testAddress = sample.AccAddress()
 
 func TestGenesisState_Validate(t *testing.T) {
+	controller := sample.AccAddress()
+	minter, otherMinter := sample.AccAddress(), sample.AccAddress()
+	allowanceCap := sdk.NewInt64Coin("test", 10)
+	attestation := types.Attestation{
+		Attestor:  controller,
+		Reserves:  sdk.NewInt64Coin("test", 100),
+		Timestamp: time.Unix(1_700_000_000, 0).UTC(),
+		Uri:       "https://example.com/report",
+		Hash:      []byte{0x01},
+	}
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -26,6 +38,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 
 				BlacklistedList: []types.Blacklisted{
 					{
@@ -43,11 +56,11 @@ func TestGenesisState_Validate(t *testing.T) {
 				},
 				MintersList: []types.Minters{
 					{
-						Address:   sample.AccAddress(),
+						Address:   minter,
 						Allowance: sdk.NewCoin("test", sdk.NewInt(1)),
 					},
 					{
-						Address:   sample.AccAddress(),
+						Address:   otherMinter,
 						Allowance: sdk.NewCoin("test", sdk.NewInt(1)),
 					},
 				},
@@ -63,11 +76,11 @@ func TestGenesisState_Validate(t *testing.T) {
 				MinterControllerList: []types.MinterController{
 					{
 						Controller: sample.AccAddress(),
-						Minter:     sample.AccAddress(),
+						Minter:     minter,
 					},
 					{
 						Controller: sample.AccAddress(),
-						Minter:     sample.AccAddress(),
+						Minter:     otherMinter,
 					},
 				},
 				MintingDenom: &types.MintingDenom{
@@ -86,7 +99,7 @@ func TestGenesisState_Validate(t *testing.T) {
 						AddressBz: sample.AddressBz(),
 					},
 					{
-						sample.AddressBz(),
+						AddressBz: sample.AddressBz(),
 					},
 				},
 				Paused: &types.Paused{
@@ -97,11 +110,11 @@ func TestGenesisState_Validate(t *testing.T) {
 				},
 				MintersList: []types.Minters{
 					{
-						Address:   sample.AccAddress(),
+						Address:   minter,
 						Allowance: sdk.NewCoin("test", sdk.NewInt(1)),
 					},
 					{
-						Address:   sample.AccAddress(),
+						Address:   otherMinter,
 						Allowance: sdk.NewCoin("test", sdk.NewInt(1)),
 					},
 				},
@@ -117,11 +130,11 @@ func TestGenesisState_Validate(t *testing.T) {
 				MinterControllerList: []types.MinterController{
 					{
 						Controller: sample.AccAddress(),
-						Minter:     sample.AccAddress(),
+						Minter:     minter,
 					},
 					{
 						Controller: sample.AccAddress(),
-						Minter:     sample.AccAddress(),
+						Minter:     otherMinter,
 					},
 				},
 				MintingDenom: &types.MintingDenom{
@@ -133,6 +146,9 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "duplicated blacklisted",
 			genState: &types.GenesisState{
+				MintingDenom: &types.MintingDenom{
+					Denom: "test",
+				},
 				BlacklistedList: []types.Blacklisted{
 					{
 						AddressBz: []byte("0"),
@@ -147,6 +163,9 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "duplicated minters",
 			genState: &types.GenesisState{
+				MintingDenom: &types.MintingDenom{
+					Denom: "test",
+				},
 				MintersList: []types.Minters{
 					{
 						Address: "0",
@@ -158,9 +177,111 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "controller of several minters",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				MintingDenom: &types.MintingDenom{
+					Denom: "test",
+				},
+				MintersList: []types.Minters{
+					{
+						Address:   minter,
+						Allowance: sdk.NewCoin("test", sdk.NewInt(1)),
+					},
+					{
+						Address:   otherMinter,
+						Allowance: sdk.NewCoin("test", sdk.NewInt(1)),
+					},
+				},
+				MinterControllerList: []types.MinterController{
+					{
+						Controller:   controller,
+						Minter:       minter,
+						AllowanceCap: &allowanceCap,
+					},
+					{
+						Controller: controller,
+						Minter:     otherMinter,
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid minterController allowance cap",
+			genState: &types.GenesisState{
+				MintingDenom: &types.MintingDenom{
+					Denom: "test",
+				},
+				Params: types.DefaultParams(),
+				MintersList: []types.Minters{
+					{
+						Address:   minter,
+						Allowance: sdk.NewCoin("test", sdk.NewInt(1)),
+					},
+				},
+				MinterControllerList: []types.MinterController{
+					{
+						Controller:   controller,
+						Minter:       minter,
+						AllowanceCap: &sdk.Coin{Denom: "test", Amount: sdk.NewInt(-1)},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "minterController controlling itself",
+			genState: &types.GenesisState{
+				MintingDenom: &types.MintingDenom{
+					Denom: "test",
+				},
+				Params: types.DefaultParams(),
+				MintersList: []types.Minters{
+					{
+						Address:   minter,
+						Allowance: sdk.NewCoin("test", sdk.NewInt(1)),
+					},
+				},
+				MinterControllerList: []types.MinterController{
+					{
+						Controller: minter,
+						Minter:     minter,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "minterController granted in another denom",
+			genState: &types.GenesisState{
+				MintingDenom: &types.MintingDenom{
+					Denom: "test",
+				},
+				Params: types.DefaultParams(),
+				MintersList: []types.Minters{
+					{
+						Address:   minter,
+						Allowance: sdk.NewCoin("test", sdk.NewInt(1)),
+					},
+				},
+				MinterControllerList: []types.MinterController{
+					{
+						Controller: controller,
+						Minter:     minter,
+						Granted:    &sdk.Coin{Denom: "other", Amount: sdk.NewInt(1)},
+					},
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "duplicated minterController",
 			genState: &types.GenesisState{
+				MintingDenom: &types.MintingDenom{
+					Denom: "test",
+				},
 				MinterControllerList: []types.MinterController{
 					{
 						Minter: "0",
@@ -172,6 +293,411 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "state without a minting denom",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Owner: &types.Owner{
+					Address: sample.AccAddress(),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "several minting denoms",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				MintingDenom: &types.MintingDenom{
+					Denom: "test",
+				},
+				Owner: &types.Owner{
+					Address: testAddress,
+				},
+				Denoms: []types.DenomGenesisState{
+					{
+						MintingDenom: types.MintingDenom{Denom: "other"},
+						Owner: &types.Owner{
+							Address: testAddress,
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated minting denom",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				MintingDenom: &types.MintingDenom{
+					Denom: "test",
+				},
+				Denoms: []types.DenomGenesisState{
+					{
+						MintingDenom: types.MintingDenom{Denom: "test"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid minting denom",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Denoms: []types.DenomGenesisState{
+					{
+						MintingDenom: types.MintingDenom{Denom: ""},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid privilege separation of a minting denom",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Denoms: []types.DenomGenesisState{
+					{
+						MintingDenom: types.MintingDenom{Denom: "other"},
+						Owner: &types.Owner{
+							Address: testAddress,
+						},
+						Pauser: &types.Pauser{
+							Address: testAddress,
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid factory denoms",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FactoryDenoms: []types.FactoryDenom{
+					{Denom: "factory/" + controller + "/a", Admin: controller},
+					{Denom: "factory/" + controller + "/b"},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated factory denom",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FactoryDenoms: []types.FactoryDenom{
+					{Denom: "factory/" + controller + "/a", Admin: controller},
+					{Denom: "factory/" + controller + "/a"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid factory denom",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FactoryDenoms: []types.FactoryDenom{
+					{Denom: "factory/invalid_address/a", Admin: controller},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "factory denom with invalid admin",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FactoryDenoms: []types.FactoryDenom{
+					{Denom: "factory/" + controller + "/a", Admin: "invalid_address"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "minting denom in the namespace of factory denoms",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Denoms: []types.DenomGenesisState{
+					{MintingDenom: types.MintingDenom{Denom: "factory/" + controller + "/a"}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "owner is also the black lister",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				MintingDenom: &types.MintingDenom{Denom: "test"},
+				Owner:        &types.Owner{Address: controller},
+				Blacklister:  &types.Blacklister{Address: controller},
+			},
+			valid: false,
+		},
+		{
+			desc: "blacklisted owner",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				MintingDenom:    &types.MintingDenom{Denom: "test"},
+				Owner:           &types.Owner{Address: controller},
+				BlacklistedList: []types.Blacklisted{{AddressBz: sdk.MustAccAddressFromBech32(controller)}},
+			},
+			valid: false,
+		},
+		{
+			desc: "blacklisted master minter of a minting denom",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Denoms: []types.DenomGenesisState{
+					{
+						MintingDenom:    types.MintingDenom{Denom: "other"},
+						MasterMinter:    &types.MasterMinter{Address: controller},
+						BlacklistedList: []types.Blacklisted{{AddressBz: sdk.MustAccAddressFromBech32(controller)}},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "empty blacklisted address",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				MintingDenom:    &types.MintingDenom{Denom: "test"},
+				BlacklistedList: []types.Blacklisted{{AddressBz: []byte{}}},
+			},
+			valid: false,
+		},
+		{
+			desc: "minter controller of an unknown minter",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				MintingDenom: &types.MintingDenom{Denom: "test"},
+				MintersList: []types.Minters{
+					{Address: minter, Allowance: sdk.NewInt64Coin("test", 1)},
+				},
+				MinterControllerList: []types.MinterController{
+					{Controller: controller, Minter: otherMinter},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "minter allowance in another denom",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				MintingDenom: &types.MintingDenom{Denom: "test"},
+				MintersList: []types.Minters{
+					{Address: minter, Allowance: sdk.NewInt64Coin("other", 1)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "minter controller allowance cap in another denom",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Denoms: []types.DenomGenesisState{
+					{
+						MintingDenom: types.MintingDenom{Denom: "other"},
+						MintersList: []types.Minters{
+							{Address: minter, Allowance: sdk.NewInt64Coin("other", 1)},
+						},
+						MinterControllerList: []types.MinterController{
+							{Controller: controller, Minter: minter, AllowanceCap: &allowanceCap},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid attestations and pending mints",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Denoms: []types.DenomGenesisState{
+					{
+						MintingDenom: types.MintingDenom{Denom: "other"},
+						Attestor:     &types.Attestor{Address: controller},
+						Attestations: []types.Attestation{
+							{Id: 0, Attestor: controller, Reserves: sdk.NewInt64Coin("other", 100), Timestamp: attestation.Timestamp, Uri: attestation.Uri, Hash: attestation.Hash},
+						},
+						PendingMints: []types.PendingMint{
+							{Id: 1, Minter: minter, Address: otherMinter, Amount: sdk.NewInt64Coin("other", 1), ExpiresAt: attestation.Timestamp},
+						},
+						PendingMintCount: 2,
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated attestation id",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Denoms: []types.DenomGenesisState{
+					{
+						MintingDenom: types.MintingDenom{Denom: "test"},
+						Attestations: []types.Attestation{attestation, attestation},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "attestation reserves in another denom",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Denoms: []types.DenomGenesisState{
+					{
+						MintingDenom: types.MintingDenom{Denom: "other"},
+						Attestations: []types.Attestation{attestation},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "attestation without report uri",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Denoms: []types.DenomGenesisState{
+					{
+						MintingDenom: types.MintingDenom{Denom: "test"},
+						Attestations: []types.Attestation{{Attestor: controller, Reserves: attestation.Reserves, Timestamp: attestation.Timestamp, Hash: attestation.Hash}},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "owner is also the attestor",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Denoms: []types.DenomGenesisState{
+					{
+						MintingDenom: types.MintingDenom{Denom: "test"},
+						Owner:        &types.Owner{Address: controller},
+						Attestor:     &types.Attestor{Address: controller},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "pending mint id above the pending mint count",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Denoms: []types.DenomGenesisState{
+					{
+						MintingDenom: types.MintingDenom{Denom: "test"},
+						PendingMints: []types.PendingMint{
+							{Id: 1, Minter: minter, Address: otherMinter, Amount: sdk.NewInt64Coin("test", 1), ExpiresAt: attestation.Timestamp},
+						},
+						PendingMintCount: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "pending mint in another denom",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Denoms: []types.DenomGenesisState{
+					{
+						MintingDenom: types.MintingDenom{Denom: "test"},
+						PendingMints: []types.PendingMint{
+							{Id: 0, Minter: minter, Address: otherMinter, Amount: sdk.NewInt64Coin("other", 1), ExpiresAt: attestation.Timestamp},
+						},
+						PendingMintCount: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid mint approval window",
+			genState: &types.GenesisState{
+				Params: types.NewParams(false, false, types.DefaultTravelRuleThreshold, nil, nil, nil, 0, nil, nil, 0, false),
+			},
+			valid: false,
+		},
+		{
+			desc: "valid redemptions",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Denoms: []types.DenomGenesisState{
+					{
+						MintingDenom: types.MintingDenom{Denom: "test"},
+						Redemptions: []types.Redemption{
+							{Id: 0, Redeemer: controller, Amount: sdk.NewInt64Coin("test", 1), BankReference: "wire 42", Status: types.REDEMPTION_STATUS_PENDING},
+							{Id: 1, Redeemer: controller, Amount: sdk.NewInt64Coin("test", 1), BankReference: "wire 43", Status: types.REDEMPTION_STATUS_FINALIZED, Minter: minter},
+						},
+						RedemptionCount: 2,
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "redemption without status",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Denoms: []types.DenomGenesisState{
+					{
+						MintingDenom: types.MintingDenom{Denom: "test"},
+						Redemptions: []types.Redemption{
+							{Id: 0, Redeemer: controller, Amount: sdk.NewInt64Coin("test", 1), BankReference: "wire 42"},
+						},
+						RedemptionCount: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "cancelled redemption without minter",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Denoms: []types.DenomGenesisState{
+					{
+						MintingDenom: types.MintingDenom{Denom: "test"},
+						Redemptions: []types.Redemption{
+							{Id: 0, Redeemer: controller, Amount: sdk.NewInt64Coin("test", 1), BankReference: "wire 42", Status: types.REDEMPTION_STATUS_CANCELLED},
+						},
+						RedemptionCount: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "redemption without bank reference",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Denoms: []types.DenomGenesisState{
+					{
+						MintingDenom: types.MintingDenom{Denom: "test"},
+						Redemptions: []types.Redemption{
+							{Id: 0, Redeemer: controller, Amount: sdk.NewInt64Coin("test", 1), Status: types.REDEMPTION_STATUS_PENDING},
+						},
+						RedemptionCount: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid supply caps",
+			genState: &types.GenesisState{
+				Params: types.NewParams(false, false, types.DefaultTravelRuleThreshold, nil, nil, nil, types.DefaultMintApprovalWindow, nil, sdk.Coins{{Denom: "test", Amount: sdk.NewInt(-1)}}, 0, false),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated mint within reserves denom",
+			genState: &types.GenesisState{
+				Params: types.NewParams(false, false, types.DefaultTravelRuleThreshold, nil, []string{"test", "test"}, nil, types.DefaultMintApprovalWindow, nil, nil, 0, false),
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
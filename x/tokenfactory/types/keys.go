@@ -1,5 +1,12 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "tokenfactory"
@@ -22,12 +29,38 @@ const (
 	BlacklistedKeyPrefix      = "Blacklisted/value/"
 	MintersKeyPrefix          = "Minters/value/"
 	MinterControllerKeyPrefix = "MinterController/value/"
+
+	MinterControllerByMinterKeyPrefix = "MinterControllerByMinter/value/"
+
+	AttestorKey          = "Attestor/value/"
+	AttestationKeyPrefix = "Attestation/value/"
+	AttestationCountKey  = "Attestation/count/"
+
+	PendingMintKeyPrefix = "PendingMint/value/"
+	PendingMintCountKey  = "PendingMint/count/"
+
+	RedemptionKeyPrefix = "Redemption/value/"
+	RedemptionCountKey  = "Redemption/count/"
+
+	// DenomKeyPrefix is the prefix of the store of each minting denom, which
+	// holds its state under the keys above.
+	DenomKeyPrefix = "Denom/value/"
 )
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
 
+// DenomPrefix returns the store key prefix of the state of a minting denom
+func DenomPrefix(denom string) []byte {
+	return append([]byte(DenomKeyPrefix), address.MustLengthPrefix([]byte(denom))...)
+}
+
+// DenomKey returns the store key p of the state of a minting denom
+func DenomKey(denom string, p string) []byte {
+	return append(DenomPrefix(denom), p...)
+}
+
 // BlacklistedKey returns the store key to retrieve a Blacklisted from the index fields
 func BlacklistedKey(addressBz []byte) []byte {
 	return append(addressBz, []byte("/")...)
@@ -39,11 +72,86 @@ func MintersKey(address string) []byte {
 }
 
 // MinterControllerKey returns the store key to retrieve a MinterController from the index fields
-func MinterControllerKey(controllerAddress string) []byte {
+func MinterControllerKey(controllerAddress string, minterAddress string) []byte {
+	return append(MinterControllerControllerPrefix(controllerAddress), []byte(minterAddress+"/")...)
+}
+
+// MinterControllerControllerPrefix returns the store key prefix of the MinterControllers of a controller
+func MinterControllerControllerPrefix(controllerAddress string) []byte {
 	return append([]byte(controllerAddress), []byte("/")...)
+}
 
+// MinterControllerByMinterKey returns the store key of the reverse index of a MinterController
+func MinterControllerByMinterKey(minterAddress string, controllerAddress string) []byte {
+	return append(MinterControllerByMinterPrefix(minterAddress), []byte(controllerAddress+"/")...)
+}
+
+// MinterControllerByMinterPrefix returns the store key prefix of the reverse index of the MinterControllers of a minter
+func MinterControllerByMinterPrefix(minterAddress string) []byte {
+	return append([]byte(minterAddress), []byte("/")...)
 }
 
 const (
+	// MintingDenomKey is the key of the minting denom of the tokenfactory
+	// before it held several, only used by migrations.
 	MintingDenomKey = "MintingDenom/value/"
+
+	MintingDenomKeyPrefix = "MintingDenoms/value/"
 )
+
+const (
+	// PendingMintByExpiryKeyPrefix is the prefix of the index of the pending
+	// mints of every minting denom by expiry time.
+	PendingMintByExpiryKeyPrefix = "PendingMintByExpiry/value/"
+
+	// MaxPendingMintExpiriesPerBlock is the max number of expired pending
+	// mints removed at the end of a block, the others being left to the
+	// following blocks.
+	MaxPendingMintExpiriesPerBlock = 100
+)
+
+const (
+	// FactoryDenomKeyPrefix is the prefix to retrieve all FactoryDenom
+	FactoryDenomKeyPrefix = "FactoryDenom/value/"
+)
+
+// FactoryDenomKey returns the store key to retrieve a FactoryDenom from the index fields
+func FactoryDenomKey(denom string) []byte {
+	return []byte(denom + "/")
+}
+
+// MintingDenomsKey returns the store key to retrieve a MintingDenom from the index fields
+func MintingDenomsKey(denom string) []byte {
+	return append([]byte(denom), []byte("/")...)
+}
+
+// AttestationKey returns the store key to retrieve an Attestation from its id
+func AttestationKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// PendingMintKey returns the store key to retrieve a PendingMint from its id
+func PendingMintKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// PendingMintByExpiryKey returns the store key indexing the pending mint id
+// of denom by its expiry time.
+func PendingMintByExpiryKey(expiresAt time.Time, denom string, id uint64) []byte {
+	key := sdk.FormatTimeBytes(expiresAt)
+	key = append(key, address.MustLengthPrefix([]byte(denom))...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// ParsePendingMintByExpiryKey returns the denom and id of the pending mint
+// indexed by a PendingMintByExpiryKey.
+func ParsePendingMintByExpiryKey(key []byte) (denom string, id uint64) {
+	key = key[len(sdk.FormatTimeBytes(time.Time{})):]
+	denomLen := int(key[0])
+	return string(key[1 : 1+denomLen]), sdk.BigEndianToUint64(key[1+denomLen:])
+}
+
+// RedemptionKey returns the store key to retrieve a Redemption from its id
+func RedemptionKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
@@ -9,9 +9,10 @@ const TypeMsgAcceptOwner = "accept_owner"
 
 var _ sdk.Msg = &MsgAcceptOwner{}
 
-func NewMsgAcceptOwner(from string) *MsgAcceptOwner {
+func NewMsgAcceptOwner(from string, denom string) *MsgAcceptOwner {
 	return &MsgAcceptOwner{
-		From: from,
+		From:  from,
+		Denom: denom,
 	}
 }
 
@@ -41,5 +42,8 @@ func (msg *MsgAcceptOwner) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
 	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
 	return nil
 }
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgApproveMint = "approve_mint"
+
+var _ sdk.Msg = &MsgApproveMint{}
+
+func NewMsgApproveMint(from string, denom string, id uint64) *MsgApproveMint {
+	return &MsgApproveMint{
+		From:  from,
+		Denom: denom,
+		Id:    id,
+	}
+}
+
+func (msg *MsgApproveMint) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgApproveMint) Type() string {
+	return TypeMsgApproveMint
+}
+
+func (msg *MsgApproveMint) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgApproveMint) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgApproveMint) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgApproveMint_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgApproveMint
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg: MsgApproveMint{
+				From:  "invalid_address",
+				Denom: "utoken",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			msg: MsgApproveMint{
+				From:  sample.AccAddress(),
+				Denom: "!",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "valid",
+			msg: MsgApproveMint{
+				From:  sample.AccAddress(),
+				Denom: "utoken",
+				Id:    1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -9,9 +9,10 @@ const TypeMsgBlacklist = "blacklist"
 
 var _ sdk.Msg = &MsgBlacklist{}
 
-func NewMsgBlacklist(from, address string) *MsgBlacklist {
+func NewMsgBlacklist(from, denom, address string) *MsgBlacklist {
 	return &MsgBlacklist{
 		From:    from,
+		Denom:   denom,
 		Address: address,
 	}
 }
@@ -46,5 +47,8 @@ func (msg *MsgBlacklist) ValidateBasic() error {
 	if len(msg.Address) <= 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "address length cannot be less than or equal to 0")
 	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
 	return nil
 }
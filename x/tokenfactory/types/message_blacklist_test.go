@@ -18,6 +18,7 @@ func TestMsgBlacklist_ValidateBasic(t *testing.T) {
 			name: "invalid from",
 			msg: MsgBlacklist{
 				From:    "invalid_address",
+				Denom:   "utoken",
 				Address: sample.AccAddress(),
 			},
 			err: sdkerrors.ErrInvalidAddress,
@@ -26,6 +27,7 @@ func TestMsgBlacklist_ValidateBasic(t *testing.T) {
 			name: "invalid block address",
 			msg: MsgBlacklist{
 				From:    sample.AccAddress(),
+				Denom:   "utoken",
 				Address: "",
 			},
 			err: sdkerrors.ErrInvalidAddress,
@@ -34,6 +36,7 @@ func TestMsgBlacklist_ValidateBasic(t *testing.T) {
 			name: "valid block and from address",
 			msg: MsgBlacklist{
 				From:    sample.AccAddress(),
+				Denom:   "utoken",
 				Address: sample.AccAddress(),
 			},
 		},
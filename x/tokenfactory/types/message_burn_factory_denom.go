@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgBurnFactoryDenom = "burn_factory_denom"
+
+var _ sdk.Msg = &MsgBurnFactoryDenom{}
+
+func NewMsgBurnFactoryDenom(from string, amount sdk.Coin) *MsgBurnFactoryDenom {
+	return &MsgBurnFactoryDenom{
+		From:   from,
+		Amount: amount,
+	}
+}
+
+func (msg *MsgBurnFactoryDenom) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBurnFactoryDenom) Type() string {
+	return TypeMsgBurnFactoryDenom
+}
+
+func (msg *MsgBurnFactoryDenom) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgBurnFactoryDenom) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBurnFactoryDenom) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid burn amount (%s)", msg.Amount)
+	}
+
+	if _, _, err := DeconstructFactoryDenom(msg.Amount.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
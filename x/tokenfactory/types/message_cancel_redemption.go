@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelRedemption = "cancel_redemption"
+
+var _ sdk.Msg = &MsgCancelRedemption{}
+
+func NewMsgCancelRedemption(from string, denom string, id uint64) *MsgCancelRedemption {
+	return &MsgCancelRedemption{
+		From:  from,
+		Denom: denom,
+		Id:    id,
+	}
+}
+
+func (msg *MsgCancelRedemption) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelRedemption) Type() string {
+	return TypeMsgCancelRedemption
+}
+
+func (msg *MsgCancelRedemption) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgCancelRedemption) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelRedemption) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
+	return nil
+}
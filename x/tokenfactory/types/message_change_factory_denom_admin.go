@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgChangeFactoryDenomAdmin = "change_factory_denom_admin"
+
+var _ sdk.Msg = &MsgChangeFactoryDenomAdmin{}
+
+func NewMsgChangeFactoryDenomAdmin(from string, denom string, admin string) *MsgChangeFactoryDenomAdmin {
+	return &MsgChangeFactoryDenomAdmin{
+		From:  from,
+		Denom: denom,
+		Admin: admin,
+	}
+}
+
+func (msg *MsgChangeFactoryDenomAdmin) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgChangeFactoryDenomAdmin) Type() string {
+	return TypeMsgChangeFactoryDenomAdmin
+}
+
+func (msg *MsgChangeFactoryDenomAdmin) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgChangeFactoryDenomAdmin) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgChangeFactoryDenomAdmin) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	if msg.Admin != "" {
+		_, err = sdk.AccAddressFromBech32(msg.Admin)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address (%s)", err)
+		}
+	}
+
+	if _, _, err := DeconstructFactoryDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
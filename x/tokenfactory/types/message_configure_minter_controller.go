@@ -9,11 +9,14 @@ const TypeMsgConfigureMinterController = "configure_minter_controller"
 
 var _ sdk.Msg = &MsgConfigureMinterController{}
 
-func NewMsgConfigureMinterController(from string, controller string, minter string) *MsgConfigureMinterController {
+func NewMsgConfigureMinterController(from string, denom string, controller string, minter string, allowanceCap *sdk.Coin, force bool) *MsgConfigureMinterController {
 	return &MsgConfigureMinterController{
-		From:       from,
-		Controller: controller,
-		Minter:     minter,
+		From:         from,
+		Denom:        denom,
+		Controller:   controller,
+		Minter:       minter,
+		AllowanceCap: allowanceCap,
+		Force:        force,
 	}
 }
 
@@ -51,5 +54,11 @@ func (msg *MsgConfigureMinterController) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address (%s)", err)
 	}
+	if msg.AllowanceCap != nil && !msg.AllowanceCap.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid allowance cap (%s)", msg.AllowanceCap)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
 	return nil
 }
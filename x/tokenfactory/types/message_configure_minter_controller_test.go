@@ -18,6 +18,7 @@ func TestMsgConfigureMinterController_ValidateBasic(t *testing.T) {
 			name: "invalid from",
 			msg: MsgConfigureMinterController{
 				From:       "invalid_address",
+				Denom:      "utoken",
 				Controller: sample.AccAddress(),
 				Minter:     sample.AccAddress(),
 			},
@@ -27,6 +28,7 @@ func TestMsgConfigureMinterController_ValidateBasic(t *testing.T) {
 			name: "invalid controller",
 			msg: MsgConfigureMinterController{
 				From:       sample.AccAddress(),
+				Denom:      "utoken",
 				Controller: "invalid_address",
 				Minter:     sample.AccAddress(),
 			},
@@ -36,6 +38,7 @@ func TestMsgConfigureMinterController_ValidateBasic(t *testing.T) {
 			name: "invalid minter",
 			msg: MsgConfigureMinterController{
 				From:       sample.AccAddress(),
+				Denom:      "utoken",
 				Controller: sample.AccAddress(),
 				Minter:     "invalid_address",
 			},
@@ -45,6 +48,7 @@ func TestMsgConfigureMinterController_ValidateBasic(t *testing.T) {
 			name: "valid address, minter, and controller",
 			msg: MsgConfigureMinterController{
 				From:       sample.AccAddress(),
+				Denom:      "utoken",
 				Controller: sample.AccAddress(),
 				Minter:     sample.AccAddress(),
 			},
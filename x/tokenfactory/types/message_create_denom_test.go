@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateDenom_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCreateDenom
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCreateDenom{
+				From:     "invalid_address",
+				Subdenom: "ufoo",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid subdenom",
+			msg: MsgCreateDenom{
+				From:     sample.AccAddress(),
+				Subdenom: "u/foo",
+			},
+			err: ErrInvalidDenom,
+		}, {
+			name: "valid address",
+			msg: MsgCreateDenom{
+				From:     sample.AccAddress(),
+				Subdenom: "ufoo",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
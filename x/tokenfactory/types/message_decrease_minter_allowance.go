@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgDecreaseMinterAllowance = "decrease_minter_allowance"
+
+var _ sdk.Msg = &MsgDecreaseMinterAllowance{}
+
+func NewMsgDecreaseMinterAllowance(from string, address string, amount sdk.Coin, expectedAllowance *sdk.Coin) *MsgDecreaseMinterAllowance {
+	return &MsgDecreaseMinterAllowance{
+		From:              from,
+		Address:           address,
+		Amount:            amount,
+		ExpectedAllowance: expectedAllowance,
+	}
+}
+
+func (msg *MsgDecreaseMinterAllowance) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDecreaseMinterAllowance) Type() string {
+	return TypeMsgDecreaseMinterAllowance
+}
+
+func (msg *MsgDecreaseMinterAllowance) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgDecreaseMinterAllowance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDecreaseMinterAllowance) ValidateBasic() error {
+	return validateMinterAllowanceChange(msg.From, msg.Address, msg.Amount, msg.ExpectedAllowance)
+}
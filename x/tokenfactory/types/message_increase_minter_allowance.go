@@ -0,0 +1,73 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgIncreaseMinterAllowance = "increase_minter_allowance"
+
+var _ sdk.Msg = &MsgIncreaseMinterAllowance{}
+
+func NewMsgIncreaseMinterAllowance(from string, address string, amount sdk.Coin, expectedAllowance *sdk.Coin) *MsgIncreaseMinterAllowance {
+	return &MsgIncreaseMinterAllowance{
+		From:              from,
+		Address:           address,
+		Amount:            amount,
+		ExpectedAllowance: expectedAllowance,
+	}
+}
+
+func (msg *MsgIncreaseMinterAllowance) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgIncreaseMinterAllowance) Type() string {
+	return TypeMsgIncreaseMinterAllowance
+}
+
+func (msg *MsgIncreaseMinterAllowance) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgIncreaseMinterAllowance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgIncreaseMinterAllowance) ValidateBasic() error {
+	return validateMinterAllowanceChange(msg.From, msg.Address, msg.Amount, msg.ExpectedAllowance)
+}
+
+// validateMinterAllowanceChange validates the fields shared by
+// MsgIncreaseMinterAllowance and MsgDecreaseMinterAllowance.
+func validateMinterAllowanceChange(from string, address string, amount sdk.Coin, expectedAllowance *sdk.Coin) error {
+	_, err := sdk.AccAddressFromBech32(from)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address (%s)", err)
+	}
+
+	if amount.IsNil() || !amount.IsValid() || !amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount must be positive: %s", amount)
+	}
+
+	if expectedAllowance != nil {
+		if expectedAllowance.IsNil() || !expectedAllowance.IsValid() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid expected allowance: %s", expectedAllowance)
+		}
+		if expectedAllowance.Denom != amount.Denom {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "expected allowance denom %s ≠ amount denom %s", expectedAllowance.Denom, amount.Denom)
+		}
+	}
+
+	return nil
+}
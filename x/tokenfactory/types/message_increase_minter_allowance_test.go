@@ -0,0 +1,75 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgIncreaseMinterAllowance_ValidateBasic(t *testing.T) {
+	otherDenom := sdk.NewCoin("other", sdk.NewInt(1))
+	tests := []struct {
+		name string
+		msg  MsgIncreaseMinterAllowance
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg: MsgIncreaseMinterAllowance{
+				From:    "invalid_address",
+				Address: sample.AccAddress(),
+				Amount:  sdk.NewCoin("test", sdk.NewInt(1)),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid address",
+			msg: MsgIncreaseMinterAllowance{
+				From:    sample.AccAddress(),
+				Address: "invalid_address",
+				Amount:  sdk.NewCoin("test", sdk.NewInt(1)),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "zero amount",
+			msg: MsgIncreaseMinterAllowance{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Amount:  sdk.NewCoin("test", sdk.ZeroInt()),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "expected allowance in another denom",
+			msg: MsgIncreaseMinterAllowance{
+				From:              sample.AccAddress(),
+				Address:           sample.AccAddress(),
+				Amount:            sdk.NewCoin("test", sdk.NewInt(1)),
+				ExpectedAllowance: &otherDenom,
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "valid",
+			msg: MsgIncreaseMinterAllowance{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Amount:  sdk.NewCoin("test", sdk.NewInt(1)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgMintFactoryDenom = "mint_factory_denom"
+
+var _ sdk.Msg = &MsgMintFactoryDenom{}
+
+func NewMsgMintFactoryDenom(from string, address string, amount sdk.Coin) *MsgMintFactoryDenom {
+	return &MsgMintFactoryDenom{
+		From:    from,
+		Address: address,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgMintFactoryDenom) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgMintFactoryDenom) Type() string {
+	return TypeMsgMintFactoryDenom
+}
+
+func (msg *MsgMintFactoryDenom) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgMintFactoryDenom) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgMintFactoryDenom) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	if msg.Address != "" {
+		_, err = sdk.AccAddressFromBech32(msg.Address)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+		}
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid mint amount (%s)", msg.Amount)
+	}
+
+	if _, _, err := DeconstructFactoryDenom(msg.Amount.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
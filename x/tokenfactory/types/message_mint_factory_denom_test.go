@@ -0,0 +1,69 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgMintFactoryDenom_ValidateBasic(t *testing.T) {
+	admin := sample.AccAddress()
+	denom := "factory/" + admin + "/ufoo"
+
+	tests := []struct {
+		name string
+		msg  MsgMintFactoryDenom
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgMintFactoryDenom{
+				From:   "invalid_address",
+				Amount: sdk.NewInt64Coin(denom, 1),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid recipient",
+			msg: MsgMintFactoryDenom{
+				From:    admin,
+				Address: "invalid_address",
+				Amount:  sdk.NewInt64Coin(denom, 1),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero amount",
+			msg: MsgMintFactoryDenom{
+				From:   admin,
+				Amount: sdk.NewInt64Coin(denom, 0),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "not a factory denom",
+			msg: MsgMintFactoryDenom{
+				From:   admin,
+				Amount: sdk.NewInt64Coin("utoken", 1),
+			},
+			err: ErrInvalidDenom,
+		}, {
+			name: "valid address",
+			msg: MsgMintFactoryDenom{
+				From:    admin,
+				Address: sample.AccAddress(),
+				Amount:  sdk.NewInt64Coin(denom, 1),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
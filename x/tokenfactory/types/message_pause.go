@@ -9,9 +9,10 @@ const TypeMsgPause = "pause"
 
 var _ sdk.Msg = &MsgPause{}
 
-func NewMsgPause(from string) *MsgPause {
+func NewMsgPause(from string, denom string) *MsgPause {
 	return &MsgPause{
-		From: from,
+		From:  from,
+		Denom: denom,
 	}
 }
 
@@ -41,5 +42,8 @@ func (msg *MsgPause) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
 	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
 	return nil
 }
@@ -17,14 +17,22 @@ func TestMsgPause_ValidateBasic(t *testing.T) {
 		{
 			name: "invalid address",
 			msg: MsgPause{
-				From: "invalid_address",
+				From:  "invalid_address",
+				Denom: "utoken",
 			},
 			err: sdkerrors.ErrInvalidAddress,
 		}, {
-			name: "valid address",
+			name: "invalid denom",
 			msg: MsgPause{
 				From: sample.AccAddress(),
 			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid address",
+			msg: MsgPause{
+				From:  sample.AccAddress(),
+				Denom: "utoken",
+			},
 		},
 	}
 	for _, tt := range tests {
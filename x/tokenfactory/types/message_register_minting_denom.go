@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRegisterMintingDenom = "register_minting_denom"
+
+var _ sdk.Msg = &MsgRegisterMintingDenom{}
+
+func NewMsgRegisterMintingDenom(authority string, denom string, owner string) *MsgRegisterMintingDenom {
+	return &MsgRegisterMintingDenom{
+		Authority: authority,
+		Denom:     denom,
+		Owner:     owner,
+	}
+}
+
+func (msg *MsgRegisterMintingDenom) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRegisterMintingDenom) Type() string {
+	return TypeMsgRegisterMintingDenom
+}
+
+func (msg *MsgRegisterMintingDenom) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgRegisterMintingDenom) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRegisterMintingDenom) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom (%s)", err)
+	}
+
+	if IsFactoryDenom(msg.Denom) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "factory denom %s can not be registered as a minting denom", msg.Denom)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	return nil
+}
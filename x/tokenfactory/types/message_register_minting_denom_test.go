@@ -0,0 +1,68 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRegisterMintingDenom_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRegisterMintingDenom
+		err  error
+	}{
+		{
+			name: "invalid authority",
+			msg: MsgRegisterMintingDenom{
+				Authority: "invalid_address",
+				Denom:     "utoken",
+				Owner:     sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid denom",
+			msg: MsgRegisterMintingDenom{
+				Authority: sample.AccAddress(),
+				Denom:     "1",
+				Owner:     sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "factory denom",
+			msg: MsgRegisterMintingDenom{
+				Authority: sample.AccAddress(),
+				Denom:     FactoryDenomPrefix + "/" + sample.AccAddress() + "/utoken",
+				Owner:     sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid owner",
+			msg: MsgRegisterMintingDenom{
+				Authority: sample.AccAddress(),
+				Denom:     "utoken",
+				Owner:     "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid",
+			msg: MsgRegisterMintingDenom{
+				Authority: sample.AccAddress(),
+				Denom:     "utoken",
+				Owner:     sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
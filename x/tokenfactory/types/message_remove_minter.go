@@ -9,9 +9,10 @@ const TypeMsgRemoveMinter = "remove_minter"
 
 var _ sdk.Msg = &MsgRemoveMinter{}
 
-func NewMsgRemoveMinter(from string, address string) *MsgRemoveMinter {
+func NewMsgRemoveMinter(from string, denom string, address string) *MsgRemoveMinter {
 	return &MsgRemoveMinter{
 		From:    from,
+		Denom:   denom,
 		Address: address,
 	}
 }
@@ -46,5 +47,8 @@ func (msg *MsgRemoveMinter) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address (%s)", err)
 	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
 	return nil
 }
@@ -18,6 +18,7 @@ func TestMsgRemoveMinterController_ValidateBasic(t *testing.T) {
 			name: "invalid from",
 			msg: MsgRemoveMinterController{
 				From:       "invalid_address",
+				Denom:      "utoken",
 				Controller: sample.AccAddress(),
 			},
 			err: sdkerrors.ErrInvalidAddress,
@@ -26,6 +27,7 @@ func TestMsgRemoveMinterController_ValidateBasic(t *testing.T) {
 			name: "invalid controller",
 			msg: MsgRemoveMinterController{
 				From:       sample.AccAddress(),
+				Denom:      "utoken",
 				Controller: "invalid_address",
 			},
 			err: sdkerrors.ErrInvalidAddress,
@@ -34,6 +36,7 @@ func TestMsgRemoveMinterController_ValidateBasic(t *testing.T) {
 			name: "valid controller and from",
 			msg: MsgRemoveMinterController{
 				From:       sample.AccAddress(),
+				Denom:      "utoken",
 				Controller: sample.AccAddress(),
 			},
 		},
@@ -18,6 +18,7 @@ func TestMsgRemoveMinter_ValidateBasic(t *testing.T) {
 			name: "invalid from",
 			msg: MsgRemoveMinter{
 				From:    "invalid_address",
+				Denom:   "utoken",
 				Address: sample.AccAddress(),
 			},
 			err: sdkerrors.ErrInvalidAddress,
@@ -26,6 +27,7 @@ func TestMsgRemoveMinter_ValidateBasic(t *testing.T) {
 			name: "invalid address",
 			msg: MsgRemoveMinter{
 				From:    sample.AccAddress(),
+				Denom:   "utoken",
 				Address: "invalid_address",
 			},
 			err: sdkerrors.ErrInvalidAddress,
@@ -34,6 +36,7 @@ func TestMsgRemoveMinter_ValidateBasic(t *testing.T) {
 			name: "valid address and from",
 			msg: MsgRemoveMinter{
 				From:    sample.AccAddress(),
+				Denom:   "utoken",
 				Address: sample.AccAddress(),
 			},
 		},
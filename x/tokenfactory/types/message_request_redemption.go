@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRequestRedemption = "request_redemption"
+
+// MaxBankReferenceLength is the max length of the bank reference of a
+// redemption.
+const MaxBankReferenceLength = 256
+
+var _ sdk.Msg = &MsgRequestRedemption{}
+
+func NewMsgRequestRedemption(from string, amount sdk.Coin, bankReference string) *MsgRequestRedemption {
+	return &MsgRequestRedemption{
+		From:          from,
+		Amount:        amount,
+		BankReference: bankReference,
+	}
+}
+
+func (msg *MsgRequestRedemption) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRequestRedemption) Type() string {
+	return TypeMsgRequestRedemption
+}
+
+func (msg *MsgRequestRedemption) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgRequestRedemption) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRequestRedemption) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount (%s)", msg.Amount)
+	}
+
+	return validateBankReference(msg.BankReference)
+}
+
+// validateBankReference validates the bank reference of a redemption.
+func validateBankReference(bankReference string) error {
+	if bankReference == "" || len(bankReference) > MaxBankReferenceLength {
+		return sdkerrors.Wrapf(ErrInvalidRedemption, "bank reference must be between 1 and %d characters", MaxBankReferenceLength)
+	}
+	return nil
+}
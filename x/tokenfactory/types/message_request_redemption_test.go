@@ -0,0 +1,56 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRequestRedemption_ValidateBasic(t *testing.T) {
+	amount := sdk.NewInt64Coin("utoken", 1000)
+
+	tests := []struct {
+		name string
+		msg  *MsgRequestRedemption
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg:  NewMsgRequestRedemption("invalid_address", amount, "IBAN DE00 0000"),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "zero amount",
+			msg:  NewMsgRequestRedemption(sample.AccAddress(), sdk.NewInt64Coin("utoken", 0), "IBAN DE00 0000"),
+			err:  sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "empty bank reference",
+			msg:  NewMsgRequestRedemption(sample.AccAddress(), amount, ""),
+			err:  ErrInvalidRedemption,
+		},
+		{
+			name: "bank reference too long",
+			msg:  NewMsgRequestRedemption(sample.AccAddress(), amount, strings.Repeat("a", MaxBankReferenceLength+1)),
+			err:  ErrInvalidRedemption,
+		},
+		{
+			name: "valid",
+			msg:  NewMsgRequestRedemption(sample.AccAddress(), amount, "IBAN DE00 0000"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetDenomMetadata_ValidateBasic(t *testing.T) {
+	metadata := banktypes.Metadata{
+		Description: "Test Token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "utest", Exponent: 0},
+			{Denom: "test", Exponent: 6},
+		},
+		Base:    "utest",
+		Display: "test",
+		Name:    "Test Token",
+		Symbol:  "TEST",
+	}
+	invalidMetadata := metadata
+	invalidMetadata.Display = "unknown"
+
+	tests := []struct {
+		name string
+		msg  MsgSetDenomMetadata
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetDenomMetadata{
+				From:     "invalid_address",
+				Metadata: metadata,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid metadata",
+			msg: MsgSetDenomMetadata{
+				From:     sample.AccAddress(),
+				Metadata: invalidMetadata,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgSetDenomMetadata{
+				From:     sample.AccAddress(),
+				Metadata: metadata,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
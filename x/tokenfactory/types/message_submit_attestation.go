@@ -0,0 +1,79 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSubmitAttestation = "submit_attestation"
+
+const (
+	// MaxAttestationURILength is the max length of the report URI of an
+	// attestation.
+	MaxAttestationURILength = 512
+	// MaxAttestationHashLength is the max length of the report hash of an
+	// attestation.
+	MaxAttestationHashLength = 64
+)
+
+var _ sdk.Msg = &MsgSubmitAttestation{}
+
+func NewMsgSubmitAttestation(from string, reserves sdk.Coin, timestamp time.Time, uri string, hash []byte) *MsgSubmitAttestation {
+	return &MsgSubmitAttestation{
+		From:      from,
+		Reserves:  reserves,
+		Timestamp: timestamp,
+		Uri:       uri,
+		Hash:      hash,
+	}
+}
+
+func (msg *MsgSubmitAttestation) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubmitAttestation) Type() string {
+	return TypeMsgSubmitAttestation
+}
+
+func (msg *MsgSubmitAttestation) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgSubmitAttestation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSubmitAttestation) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	if msg.Reserves.IsNil() || !msg.Reserves.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid reserves (%s)", msg.Reserves)
+	}
+
+	return validateAttestationReport(msg.Timestamp, msg.Uri, msg.Hash)
+}
+
+// validateAttestationReport validates the report fields of an attestation.
+func validateAttestationReport(timestamp time.Time, uri string, hash []byte) error {
+	if timestamp.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidAttestation, "timestamp cannot be zero")
+	}
+	if uri == "" || len(uri) > MaxAttestationURILength {
+		return sdkerrors.Wrapf(ErrInvalidAttestation, "uri must be between 1 and %d characters", MaxAttestationURILength)
+	}
+	if len(hash) == 0 || len(hash) > MaxAttestationHashLength {
+		return sdkerrors.Wrapf(ErrInvalidAttestation, "hash must be between 1 and %d bytes", MaxAttestationHashLength)
+	}
+	return nil
+}
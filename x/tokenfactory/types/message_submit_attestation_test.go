@@ -0,0 +1,74 @@
+package types
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSubmitAttestation_ValidateBasic(t *testing.T) {
+	timestamp := time.Unix(1_700_000_000, 0).UTC()
+	reserves := sdk.NewInt64Coin("utoken", 1000)
+	hash := []byte{0x01, 0x02, 0x03}
+
+	tests := []struct {
+		name string
+		msg  *MsgSubmitAttestation
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg:  NewMsgSubmitAttestation("invalid_address", reserves, timestamp, "https://example.com", hash),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid reserves",
+			msg:  NewMsgSubmitAttestation(sample.AccAddress(), sdk.Coin{Denom: "utoken"}, timestamp, "https://example.com", hash),
+			err:  sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "zero timestamp",
+			msg:  NewMsgSubmitAttestation(sample.AccAddress(), reserves, time.Time{}, "https://example.com", hash),
+			err:  ErrInvalidAttestation,
+		},
+		{
+			name: "empty uri",
+			msg:  NewMsgSubmitAttestation(sample.AccAddress(), reserves, timestamp, "", hash),
+			err:  ErrInvalidAttestation,
+		},
+		{
+			name: "uri too long",
+			msg:  NewMsgSubmitAttestation(sample.AccAddress(), reserves, timestamp, strings.Repeat("a", MaxAttestationURILength+1), hash),
+			err:  ErrInvalidAttestation,
+		},
+		{
+			name: "empty hash",
+			msg:  NewMsgSubmitAttestation(sample.AccAddress(), reserves, timestamp, "https://example.com", nil),
+			err:  ErrInvalidAttestation,
+		},
+		{
+			name: "hash too long",
+			msg:  NewMsgSubmitAttestation(sample.AccAddress(), reserves, timestamp, "https://example.com", make([]byte, MaxAttestationHashLength+1)),
+			err:  ErrInvalidAttestation,
+		},
+		{
+			name: "valid",
+			msg:  NewMsgSubmitAttestation(sample.AccAddress(), reserves, timestamp, "https://example.com", hash),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -9,9 +9,10 @@ const TypeMsgUnblacklist = "unblacklist"
 
 var _ sdk.Msg = &MsgUnblacklist{}
 
-func NewMsgUnblacklist(from, address string) *MsgUnblacklist {
+func NewMsgUnblacklist(from, denom, address string) *MsgUnblacklist {
 	return &MsgUnblacklist{
 		From:    from,
+		Denom:   denom,
 		Address: address,
 	}
 }
@@ -46,5 +47,8 @@ func (msg *MsgUnblacklist) ValidateBasic() error {
 	if len(msg.Address) <= 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "address length cannot be less than or equal to 0")
 	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
 	return nil
 }
@@ -18,6 +18,7 @@ func TestMsgUnblacklist_ValidateBasic(t *testing.T) {
 			name: "invalid from",
 			msg: MsgUnblacklist{
 				From:    "invalid_address",
+				Denom:   "utoken",
 				Address: sample.AccAddress(),
 			},
 			err: sdkerrors.ErrInvalidAddress,
@@ -26,6 +27,7 @@ func TestMsgUnblacklist_ValidateBasic(t *testing.T) {
 			name: "invalid block address",
 			msg: MsgUnblacklist{
 				From:    sample.AccAddress(),
+				Denom:   "utoken",
 				Address: "",
 			},
 			err: sdkerrors.ErrInvalidAddress,
@@ -34,6 +36,7 @@ func TestMsgUnblacklist_ValidateBasic(t *testing.T) {
 			name: "valid block address and from",
 			msg: MsgUnblacklist{
 				From:    sample.AccAddress(),
+				Denom:   "utoken",
 				Address: sample.AccAddress(),
 			},
 		},
@@ -9,9 +9,10 @@ const TypeMsgUnpause = "unpause"
 
 var _ sdk.Msg = &MsgUnpause{}
 
-func NewMsgUnpause(from string) *MsgUnpause {
+func NewMsgUnpause(from string, denom string) *MsgUnpause {
 	return &MsgUnpause{
-		From: from,
+		From:  from,
+		Denom: denom,
 	}
 }
 
@@ -41,5 +42,8 @@ func (msg *MsgUnpause) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
 	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
 	return nil
 }
@@ -17,13 +17,15 @@ func TestMsgUnpause_ValidateBasic(t *testing.T) {
 		{
 			name: "invalid address",
 			msg: MsgUnpause{
-				From: "invalid_address",
+				From:  "invalid_address",
+				Denom: "utoken",
 			},
 			err: sdkerrors.ErrInvalidAddress,
 		}, {
 			name: "valid address",
 			msg: MsgUnpause{
-				From: sample.AccAddress(),
+				From:  sample.AccAddress(),
+				Denom: "utoken",
 			},
 		},
 	}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateAttestor = "update_attestor"
+
+var _ sdk.Msg = &MsgUpdateAttestor{}
+
+func NewMsgUpdateAttestor(from string, denom string, address string) *MsgUpdateAttestor {
+	return &MsgUpdateAttestor{
+		From:    from,
+		Denom:   denom,
+		Address: address,
+	}
+}
+
+func (msg *MsgUpdateAttestor) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateAttestor) Type() string {
+	return TypeMsgUpdateAttestor
+}
+
+func (msg *MsgUpdateAttestor) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgUpdateAttestor) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateAttestor) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid attestor address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
+	return nil
+}
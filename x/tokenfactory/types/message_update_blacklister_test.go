@@ -18,6 +18,7 @@ func TestMsgUpdateBlacklister_ValidateBasic(t *testing.T) {
 			name: "invalid from",
 			msg: MsgUpdateBlacklister{
 				From:    "invalid_address",
+				Denom:   "utoken",
 				Address: sample.AccAddress(),
 			},
 			err: sdkerrors.ErrInvalidAddress,
@@ -26,6 +27,7 @@ func TestMsgUpdateBlacklister_ValidateBasic(t *testing.T) {
 			name: "invalid address",
 			msg: MsgUpdateBlacklister{
 				From:    sample.AccAddress(),
+				Denom:   "utoken",
 				Address: "invalid_address",
 			},
 			err: sdkerrors.ErrInvalidAddress,
@@ -34,6 +36,7 @@ func TestMsgUpdateBlacklister_ValidateBasic(t *testing.T) {
 			name: "valid address and from",
 			msg: MsgUpdateBlacklister{
 				From:    sample.AccAddress(),
+				Denom:   "utoken",
 				Address: sample.AccAddress(),
 			},
 		},
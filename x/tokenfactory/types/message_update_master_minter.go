@@ -9,9 +9,10 @@ const TypeMsgUpdateMasterMinter = "update_master_minter"
 
 var _ sdk.Msg = &MsgUpdateMasterMinter{}
 
-func NewMsgUpdateMasterMinter(from string, address string) *MsgUpdateMasterMinter {
+func NewMsgUpdateMasterMinter(from string, denom string, address string) *MsgUpdateMasterMinter {
 	return &MsgUpdateMasterMinter{
 		From:    from,
+		Denom:   denom,
 		Address: address,
 	}
 }
@@ -46,5 +47,8 @@ func (msg *MsgUpdateMasterMinter) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid master minter address (%s)", err)
 	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
 	return nil
 }
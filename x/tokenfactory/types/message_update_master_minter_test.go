@@ -18,6 +18,7 @@ func TestMsgUpdateMasterMinter_ValidateBasic(t *testing.T) {
 			name: "invalid from",
 			msg: MsgUpdateMasterMinter{
 				From:    "invalid_address",
+				Denom:   "utoken",
 				Address: sample.AccAddress(),
 			},
 			err: sdkerrors.ErrInvalidAddress,
@@ -26,6 +27,7 @@ func TestMsgUpdateMasterMinter_ValidateBasic(t *testing.T) {
 			name: "invalid address",
 			msg: MsgUpdateMasterMinter{
 				From:    sample.AccAddress(),
+				Denom:   "utoken",
 				Address: "invalid_address",
 			},
 			err: sdkerrors.ErrInvalidAddress,
@@ -34,6 +36,7 @@ func TestMsgUpdateMasterMinter_ValidateBasic(t *testing.T) {
 			name: "valid address and from",
 			msg: MsgUpdateMasterMinter{
 				From:    sample.AccAddress(),
+				Denom:   "utoken",
 				Address: sample.AccAddress(),
 			},
 		},
@@ -9,9 +9,10 @@ const TypeMsgUpdateOwner = "update_owner"
 
 var _ sdk.Msg = &MsgUpdateOwner{}
 
-func NewMsgUpdateOwner(from string, address string) *MsgUpdateOwner {
+func NewMsgUpdateOwner(from string, denom string, address string) *MsgUpdateOwner {
 	return &MsgUpdateOwner{
 		From:    from,
+		Denom:   denom,
 		Address: address,
 	}
 }
@@ -46,5 +47,8 @@ func (msg *MsgUpdateOwner) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
 	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
 	return nil
 }
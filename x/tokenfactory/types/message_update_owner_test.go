@@ -18,6 +18,7 @@ func TestMsgUpdateOwner_ValidateBasic(t *testing.T) {
 			name: "invalid from",
 			msg: MsgUpdateOwner{
 				From:    "invalid_address",
+				Denom:   "utoken",
 				Address: sample.AccAddress(),
 			},
 			err: sdkerrors.ErrInvalidAddress,
@@ -26,14 +27,24 @@ func TestMsgUpdateOwner_ValidateBasic(t *testing.T) {
 			name: "invalid address",
 			msg: MsgUpdateOwner{
 				From:    sample.AccAddress(),
+				Denom:   "utoken",
 				Address: "invalid_address",
 			},
 			err: sdkerrors.ErrInvalidAddress,
 		},
+		{
+			name: "invalid denom",
+			msg: MsgUpdateOwner{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
 		{
 			name: "valid address and from",
 			msg: MsgUpdateOwner{
 				From:    sample.AccAddress(),
+				Denom:   "utoken",
 				Address: sample.AccAddress(),
 			},
 		},
@@ -9,9 +9,10 @@ const TypeMsgUpdatePauser = "update_pauser"
 
 var _ sdk.Msg = &MsgUpdatePauser{}
 
-func NewMsgUpdatePauser(from string, address string) *MsgUpdatePauser {
+func NewMsgUpdatePauser(from string, denom string, address string) *MsgUpdatePauser {
 	return &MsgUpdatePauser{
 		From:    from,
+		Denom:   denom,
 		Address: address,
 	}
 }
@@ -46,5 +47,8 @@ func (msg *MsgUpdatePauser) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid pauser address (%s)", err)
 	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
 	return nil
 }
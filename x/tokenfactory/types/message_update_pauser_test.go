@@ -18,6 +18,7 @@ func TestMsgUpdatePauser_ValidateBasic(t *testing.T) {
 			name: "invalid from",
 			msg: MsgUpdatePauser{
 				From:    "invalid_address",
+				Denom:   "utoken",
 				Address: sample.AccAddress(),
 			},
 			err: sdkerrors.ErrInvalidAddress,
@@ -26,6 +27,7 @@ func TestMsgUpdatePauser_ValidateBasic(t *testing.T) {
 			name: "invalid address",
 			msg: MsgUpdatePauser{
 				From:    sample.AccAddress(),
+				Denom:   "utoken",
 				Address: "invalid_address",
 			},
 			err: sdkerrors.ErrInvalidAddress,
@@ -34,6 +36,7 @@ func TestMsgUpdatePauser_ValidateBasic(t *testing.T) {
 			name: "valid address and from",
 			msg: MsgUpdatePauser{
 				From:    sample.AccAddress(),
+				Denom:   "utoken",
 				Address: sample.AccAddress(),
 			},
 		},
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -22,9 +23,18 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MinterController authorizes a controller to manage the allowance of a
+// minter. A controller can manage several minters, and a minter can be
+// managed by several controllers.
 type MinterController struct {
 	Minter     string `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter,omitempty"`
 	Controller string `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
+	// allowance_cap, if set, is the max cumulative allowance the controller can
+	// give the minter.
+	AllowanceCap *types.Coin `protobuf:"bytes,3,opt,name=allowance_cap,json=allowanceCap,proto3" json:"allowance_cap,omitempty"`
+	// granted is the cumulative allowance the controller has given the minter,
+	// less the unused allowance it took back.
+	Granted *types.Coin `protobuf:"bytes,4,opt,name=granted,proto3" json:"granted,omitempty"`
 }
 
 func (m *MinterController) Reset()         { *m = MinterController{} }
@@ -74,6 +84,20 @@ func (m *MinterController) GetController() string {
 	return ""
 }
 
+func (m *MinterController) GetAllowanceCap() *types.Coin {
+	if m != nil {
+		return m.AllowanceCap
+	}
+	return nil
+}
+
+func (m *MinterController) GetGranted() *types.Coin {
+	if m != nil {
+		return m.Granted
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MinterController)(nil), "noble.tokenfactory.MinterController")
 }
@@ -83,19 +107,24 @@ func init() {
 }
 
 var fileDescriptor_08f20cf60d2060bd = []byte{
-	// 179 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0xc9, 0xcf, 0x4e,
-	0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0xcf, 0xcd, 0xcc, 0x2b, 0x49, 0x2d, 0x8a,
-	0x4f, 0xce, 0xcf, 0x2b, 0x29, 0xca, 0xcf, 0xc9, 0x49, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0xca, 0xcb, 0x4f, 0xca, 0x49, 0xd5, 0x43, 0x56, 0xab, 0xe4, 0xc5, 0x25, 0xe0, 0x0b,
-	0x56, 0xee, 0x0c, 0x57, 0x2d, 0x24, 0xc6, 0xc5, 0x06, 0x31, 0x42, 0x82, 0x51, 0x81, 0x51, 0x83,
-	0x33, 0x08, 0xca, 0x13, 0x92, 0xe3, 0xe2, 0x42, 0x98, 0x29, 0xc1, 0x04, 0x96, 0x43, 0x12, 0x71,
-	0xf2, 0x3f, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c,
-	0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xd3, 0xf4, 0xcc, 0x92,
-	0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xb0, 0x23, 0x74, 0x13, 0x8b, 0x8b, 0x53, 0x4b,
-	0x8a, 0x21, 0x1c, 0xfd, 0x32, 0x53, 0xfd, 0x0a, 0x7d, 0x14, 0x2f, 0x94, 0x54, 0x16, 0xa4, 0x16,
-	0x27, 0xb1, 0x81, 0xdd, 0x6d, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x35, 0xb3, 0xd2, 0xdf,
-	0x00, 0x00, 0x00,
+	// 266 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x50, 0xbd, 0x4e, 0xf3, 0x30,
+	0x14, 0x8d, 0xbf, 0x0f, 0x15, 0x61, 0x40, 0x42, 0x1e, 0x50, 0x60, 0xb0, 0x2a, 0xc4, 0xd0, 0x05,
+	0x5b, 0xa5, 0xea, 0xca, 0x40, 0x66, 0x84, 0xd4, 0x91, 0xa5, 0x72, 0xcc, 0xa5, 0x44, 0x38, 0xbe,
+	0x91, 0x7d, 0x29, 0xf4, 0x2d, 0x78, 0x23, 0x56, 0xc6, 0x8e, 0x8c, 0x28, 0x79, 0x11, 0x44, 0x52,
+	0x4a, 0x99, 0x18, 0xcf, 0xbd, 0xe7, 0x4f, 0x87, 0x9f, 0x12, 0x3e, 0x80, 0xbf, 0x33, 0x96, 0x30,
+	0x2c, 0x74, 0x59, 0x78, 0x82, 0x30, 0xb5, 0xe8, 0x29, 0xa0, 0x73, 0x10, 0x54, 0x15, 0x90, 0x50,
+	0x08, 0x8f, 0xb9, 0x03, 0xb5, 0xc9, 0x3d, 0x96, 0x16, 0x63, 0x89, 0x51, 0xe7, 0x26, 0x82, 0x9e,
+	0x0f, 0x73, 0x20, 0x33, 0xd4, 0x16, 0x0b, 0xdf, 0x69, 0x4e, 0x5e, 0x19, 0x3f, 0xb8, 0x6a, 0xfd,
+	0xb2, 0xb5, 0x9d, 0x38, 0xe4, 0xbd, 0x2e, 0x23, 0x65, 0x7d, 0x36, 0xd8, 0x99, 0xac, 0x90, 0x90,
+	0x9c, 0xff, 0x84, 0xa6, 0xff, 0xda, 0xdf, 0xc6, 0x45, 0x5c, 0xf0, 0x7d, 0xe3, 0x1c, 0x3e, 0x19,
+	0x6f, 0x61, 0x6a, 0x4d, 0x95, 0xfe, 0xef, 0xb3, 0xc1, 0xee, 0xf9, 0x91, 0xea, 0x4a, 0xa8, 0xaf,
+	0x12, 0x6a, 0x55, 0x42, 0x65, 0x58, 0xf8, 0xc9, 0xde, 0x9a, 0x9f, 0x99, 0x4a, 0x8c, 0xf8, 0xf6,
+	0x2c, 0x18, 0x4f, 0x70, 0x9b, 0x6e, 0xfd, 0xa5, 0xfc, 0x66, 0x5e, 0x5e, 0xbf, 0xd5, 0x92, 0x2d,
+	0x6b, 0xc9, 0x3e, 0x6a, 0xc9, 0x5e, 0x1a, 0x99, 0x2c, 0x1b, 0x99, 0xbc, 0x37, 0x32, 0xb9, 0x19,
+	0xcf, 0x0a, 0xba, 0x7f, 0xcc, 0x95, 0xc5, 0x52, 0xb7, 0xd3, 0x9c, 0x99, 0x18, 0x81, 0x62, 0x07,
+	0xf4, 0x7c, 0xac, 0x9f, 0xf5, 0xaf, 0x61, 0x69, 0x51, 0x41, 0xcc, 0x7b, 0xed, 0x32, 0xa3, 0xcf,
+	0x01, 0x00, 0x0f, 0x16, 0xe5, 0xe6, 0x75, 0x01, 0x00, 0x00,
 }
 
 func (m *MinterController) Marshal() (dAtA []byte, err error) {
@@ -118,6 +147,30 @@ func (m *MinterController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Granted != nil {
+		{
+			size, err := m.Granted.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMinterController(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AllowanceCap != nil {
+		{
+			size, err := m.AllowanceCap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMinterController(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Controller) > 0 {
 		i -= len(m.Controller)
 		copy(dAtA[i:], m.Controller)
@@ -160,6 +213,14 @@ func (m *MinterController) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMinterController(uint64(l))
 	}
+	if m.AllowanceCap != nil {
+		l = m.AllowanceCap.Size()
+		n += 1 + l + sovMinterController(uint64(l))
+	}
+	if m.Granted != nil {
+		l = m.Granted.Size()
+		n += 1 + l + sovMinterController(uint64(l))
+	}
 	return n
 }
 
@@ -262,6 +323,78 @@ func (m *MinterController) Unmarshal(dAtA []byte) error {
 			}
 			m.Controller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowanceCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinterController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMinterController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinterController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllowanceCap == nil {
+				m.AllowanceCap = &types.Coin{}
+			}
+			if err := m.AllowanceCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinterController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMinterController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinterController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Granted == nil {
+				m.Granted = &types.Coin{}
+			}
+			if err := m.Granted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMinterController(dAtA[iNdEx:])
@@ -1,34 +1,201 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyRejectBlacklistedSigners   = []byte("RejectBlacklistedSigners")
+	KeyTravelRuleEnabled          = []byte("TravelRuleEnabled")
+	KeyTravelRuleThreshold        = []byte("TravelRuleThreshold")
+	KeyDenomCreationFee           = []byte("DenomCreationFee")
+	KeyMintWithinReservesDenoms   = []byte("MintWithinReservesDenoms")
+	KeyMintApprovalThresholds     = []byte("MintApprovalThresholds")
+	KeyMintApprovalWindow         = []byte("MintApprovalWindow")
+	KeyMaxMintAmounts             = []byte("MaxMintAmounts")
+	KeySupplyCaps                 = []byte("SupplyCaps")
+	KeyMaxMinters                 = []byte("MaxMinters")
+	KeyAllowBlacklistedIBCReceive = []byte("AllowBlacklistedIBCReceive")
+)
+
+// DefaultTravelRuleThreshold is 3,000 whole tokens of a 6 decimals denom.
+var DefaultTravelRuleThreshold = sdk.NewInt(3_000_000_000)
+
+// DefaultMintApprovalWindow leaves a day to approve a pending mint.
+var DefaultMintApprovalWindow = 24 * time.Hour
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(rejectBlacklistedSigners bool, travelRuleEnabled bool, travelRuleThreshold sdk.Int, denomCreationFee sdk.Coins, mintWithinReservesDenoms []string, mintApprovalThresholds sdk.Coins, mintApprovalWindow time.Duration, maxMintAmounts sdk.Coins, supplyCaps sdk.Coins, maxMinters uint64, allowBlacklistedIBCReceive bool) Params {
+	return Params{
+		RejectBlacklistedSigners:   rejectBlacklistedSigners,
+		TravelRuleEnabled:          travelRuleEnabled,
+		TravelRuleThreshold:        travelRuleThreshold,
+		DenomCreationFee:           denomCreationFee,
+		MintWithinReservesDenoms:   mintWithinReservesDenoms,
+		MintApprovalThresholds:     mintApprovalThresholds,
+		MintApprovalWindow:         mintApprovalWindow,
+		MaxMintAmounts:             maxMintAmounts,
+		SupplyCaps:                 supplyCaps,
+		MaxMinters:                 maxMinters,
+		AllowBlacklistedIbcReceive: allowBlacklistedIBCReceive,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(false, false, DefaultTravelRuleThreshold, nil, nil, nil, DefaultMintApprovalWindow, nil, nil, 0, false)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRejectBlacklistedSigners, &p.RejectBlacklistedSigners, validateBool),
+		paramtypes.NewParamSetPair(KeyTravelRuleEnabled, &p.TravelRuleEnabled, validateBool),
+		paramtypes.NewParamSetPair(KeyTravelRuleThreshold, &p.TravelRuleThreshold, validateTravelRuleThreshold),
+		paramtypes.NewParamSetPair(KeyDenomCreationFee, &p.DenomCreationFee, validateDenomCreationFee),
+		paramtypes.NewParamSetPair(KeyMintWithinReservesDenoms, &p.MintWithinReservesDenoms, validateMintWithinReservesDenoms),
+		paramtypes.NewParamSetPair(KeyMintApprovalThresholds, &p.MintApprovalThresholds, validateMintApprovalThresholds),
+		paramtypes.NewParamSetPair(KeyMintApprovalWindow, &p.MintApprovalWindow, validateMintApprovalWindow),
+		paramtypes.NewParamSetPair(KeyMaxMintAmounts, &p.MaxMintAmounts, validateMaxMintAmounts),
+		paramtypes.NewParamSetPair(KeySupplyCaps, &p.SupplyCaps, validateSupplyCaps),
+		paramtypes.NewParamSetPair(KeyMaxMinters, &p.MaxMinters, validateMaxMinters),
+		paramtypes.NewParamSetPair(KeyAllowBlacklistedIBCReceive, &p.AllowBlacklistedIbcReceive, validateBool),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateTravelRuleThreshold(p.TravelRuleThreshold); err != nil {
+		return err
+	}
+	if err := validateDenomCreationFee(p.DenomCreationFee); err != nil {
+		return err
+	}
+	if err := validateMintWithinReservesDenoms(p.MintWithinReservesDenoms); err != nil {
+		return err
+	}
+	if err := validateMintApprovalThresholds(p.MintApprovalThresholds); err != nil {
+		return err
+	}
+	if err := validateMintApprovalWindow(p.MintApprovalWindow); err != nil {
+		return err
+	}
+	if err := validateMaxMintAmounts(p.MaxMintAmounts); err != nil {
+		return err
+	}
+	if err := validateSupplyCaps(p.SupplyCaps); err != nil {
+		return err
+	}
+	return validateMaxMinters(p.MaxMinters)
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateTravelRuleThreshold(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("travel rule threshold must be non-negative: %s", v)
+	}
+	return nil
+}
+
+func validateDenomCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee: %w", err)
+	}
+	return nil
+}
+
+func validateMintWithinReservesDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]struct{})
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid mint within reserves denom: %w", err)
+		}
+		if _, ok := seen[denom]; ok {
+			return fmt.Errorf("duplicate mint within reserves denom: %s", denom)
+		}
+		seen[denom] = struct{}{}
+	}
+	return nil
+}
+
+func validateMintApprovalThresholds(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid mint approval thresholds: %w", err)
+	}
+	return nil
+}
+
+func validateMintApprovalWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("mint approval window must be positive: %s", v)
+	}
+	return nil
+}
+
+func validateMaxMintAmounts(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid max mint amounts: %w", err)
+	}
+	return nil
+}
+
+func validateSupplyCaps(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid supply caps: %w", err)
+	}
+	return nil
+}
+
+func validateMaxMinters(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
 	return nil
 }
 
@@ -5,17 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +31,39 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// reject_blacklisted_signers rejects every tx signed by a blacklisted
+	// address, whatever it does, instead of only the txs moving the minting
+	// denom.
+	RejectBlacklistedSigners bool `protobuf:"varint,1,opt,name=reject_blacklisted_signers,json=rejectBlacklistedSigners,proto3" json:"reject_blacklisted_signers,omitempty" yaml:"reject_blacklisted_signers"`
+	// travel_rule_enabled requires a travel rule memo on the MsgSend and
+	// MsgTransfer moving more than travel_rule_threshold of the minting denom.
+	TravelRuleEnabled   bool                                   `protobuf:"varint,2,opt,name=travel_rule_enabled,json=travelRuleEnabled,proto3" json:"travel_rule_enabled,omitempty" yaml:"travel_rule_enabled"`
+	TravelRuleThreshold github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=travel_rule_threshold,json=travelRuleThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"travel_rule_threshold" yaml:"travel_rule_threshold"`
+	// denom_creation_fee is paid to the fee collector by the creator of a
+	// factory denom.
+	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=denom_creation_fee,json=denomCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fee" yaml:"denom_creation_fee"`
+	// mint_within_reserves_denoms lists the minting denoms whose mints are
+	// rejected when they would bring the supply above the reserves of the
+	// latest attestation.
+	MintWithinReservesDenoms []string `protobuf:"bytes,5,rep,name=mint_within_reserves_denoms,json=mintWithinReservesDenoms,proto3" json:"mint_within_reserves_denoms,omitempty" yaml:"mint_within_reserves_denoms"`
+	// mint_approval_thresholds holds, per minting denom, the amount above which
+	// a mint is left pending until a minter controller of its minter approves
+	// it within mint_approval_window. Minting denoms without a threshold never
+	// require an approval.
+	MintApprovalThresholds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=mint_approval_thresholds,json=mintApprovalThresholds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"mint_approval_thresholds" yaml:"mint_approval_thresholds"`
+	MintApprovalWindow     time.Duration                            `protobuf:"bytes,7,opt,name=mint_approval_window,json=mintApprovalWindow,proto3,stdduration" json:"mint_approval_window" yaml:"mint_approval_window"`
+	// max_mint_amounts holds, per minting denom, the max amount a single mint
+	// can mint. Minting denoms without one are not limited.
+	MaxMintAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=max_mint_amounts,json=maxMintAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_mint_amounts" yaml:"max_mint_amounts"`
+	// supply_caps holds, per minting denom, the max total supply minting can
+	// bring it to. Minting denoms without one are not capped.
+	SupplyCaps github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=supply_caps,json=supplyCaps,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"supply_caps" yaml:"supply_caps"`
+	// max_minters is the max number of minters of each minting denom, or 0 for
+	// no limit.
+	MaxMinters uint64 `protobuf:"varint,10,opt,name=max_minters,json=maxMinters,proto3" json:"max_minters,omitempty" yaml:"max_minters"`
+	// allow_blacklisted_ibc_receive lets blacklisted addresses receive a minting
+	// denom over IBC, which is rejected otherwise.
+	AllowBlacklistedIbcReceive bool `protobuf:"varint,11,opt,name=allow_blacklisted_ibc_receive,json=allowBlacklistedIbcReceive,proto3" json:"allow_blacklisted_ibc_receive,omitempty" yaml:"allow_blacklisted_ibc_receive"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +98,76 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetRejectBlacklistedSigners() bool {
+	if m != nil {
+		return m.RejectBlacklistedSigners
+	}
+	return false
+}
+
+func (m *Params) GetTravelRuleEnabled() bool {
+	if m != nil {
+		return m.TravelRuleEnabled
+	}
+	return false
+}
+
+func (m *Params) GetDenomCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DenomCreationFee
+	}
+	return nil
+}
+
+func (m *Params) GetMintWithinReservesDenoms() []string {
+	if m != nil {
+		return m.MintWithinReservesDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetMintApprovalThresholds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MintApprovalThresholds
+	}
+	return nil
+}
+
+func (m *Params) GetMintApprovalWindow() time.Duration {
+	if m != nil {
+		return m.MintApprovalWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMintAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxMintAmounts
+	}
+	return nil
+}
+
+func (m *Params) GetSupplyCaps() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SupplyCaps
+	}
+	return nil
+}
+
+func (m *Params) GetMaxMinters() uint64 {
+	if m != nil {
+		return m.MaxMinters
+	}
+	return 0
+}
+
+func (m *Params) GetAllowBlacklistedIbcReceive() bool {
+	if m != nil {
+		return m.AllowBlacklistedIbcReceive
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "noble.tokenfactory.Params")
 }
@@ -66,17 +175,52 @@ func init() {
 func init() { proto.RegisterFile("tokenfactory/params.proto", fileDescriptor_0f39a375875b281a) }
 
 var fileDescriptor_0f39a375875b281a = []byte{
-	// 157 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0xc9, 0xcf, 0x4e,
-	0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca, 0xcb, 0x4f, 0xca, 0x49, 0xd5, 0x43, 0x56, 0x20,
-	0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x96, 0xd6, 0x07, 0xb1, 0x20, 0x2a, 0x95, 0xf8, 0xb8, 0xd8,
-	0x02, 0xc0, 0x3a, 0xad, 0x58, 0x66, 0x2c, 0x90, 0x67, 0x70, 0xf2, 0x3f, 0xf1, 0x48, 0x8e, 0xf1,
-	0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xd3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc,
-	0x5c, 0x7d, 0xb0, 0xf1, 0xba, 0x89, 0xc5, 0xc5, 0xa9, 0x25, 0xc5, 0x10, 0x8e, 0x7e, 0x99, 0xa9,
-	0x7e, 0x85, 0x3e, 0x8a, 0x8b, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xf6, 0x18, 0x03,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x7e, 0x88, 0x46, 0x7b, 0xae, 0x00, 0x00, 0x00,
+	// 714 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8d, 0x1f, 0x3c, 0x1e, 0x4c, 0xa4, 0x27, 0x9e, 0xe1, 0x51, 0x13, 0x5a, 0x3b, 0xb5, 0x5a,
+	0x9a, 0x0d, 0xb6, 0x68, 0x85, 0x2a, 0xb1, 0xab, 0xa1, 0x48, 0xa8, 0x82, 0x56, 0xa6, 0x12, 0x52,
+	0x37, 0xd6, 0xd8, 0xb9, 0x24, 0x6e, 0x6c, 0x8f, 0xe5, 0x99, 0x24, 0x64, 0xdb, 0x2f, 0x60, 0x55,
+	0xb1, 0xec, 0xae, 0x55, 0xbf, 0x84, 0x25, 0xcb, 0xaa, 0x0b, 0x53, 0xc1, 0x1f, 0xe4, 0x0b, 0x2a,
+	0xcf, 0x0c, 0xc4, 0x14, 0x0a, 0x65, 0x15, 0xcf, 0x9c, 0x7b, 0xcf, 0x39, 0x73, 0x26, 0x73, 0xd1,
+	0x3c, 0x23, 0x1d, 0x48, 0xf6, 0x70, 0xc0, 0x48, 0x36, 0xb0, 0x53, 0x9c, 0xe1, 0x98, 0x5a, 0x69,
+	0x46, 0x18, 0x51, 0xd5, 0x84, 0xf8, 0x11, 0x58, 0xe5, 0x82, 0x9a, 0x1e, 0x10, 0x1a, 0x13, 0x6a,
+	0xfb, 0x98, 0x82, 0xdd, 0x5b, 0xf6, 0x81, 0xe1, 0x65, 0x3b, 0x20, 0x61, 0x22, 0x7a, 0x6a, 0xb3,
+	0x2d, 0xd2, 0x22, 0xfc, 0xd3, 0x2e, 0xbe, 0xe4, 0xae, 0xde, 0x22, 0xa4, 0x15, 0x81, 0xcd, 0x57,
+	0x7e, 0x77, 0xcf, 0x6e, 0x76, 0x33, 0xcc, 0x42, 0x22, 0xbb, 0xcc, 0xcf, 0x08, 0x4d, 0xbc, 0xe1,
+	0xd2, 0x6a, 0x80, 0x6a, 0x19, 0xbc, 0x87, 0x80, 0x79, 0x7e, 0x84, 0x83, 0x4e, 0x14, 0x52, 0x06,
+	0x4d, 0x8f, 0x86, 0xad, 0x04, 0x32, 0xaa, 0x29, 0x75, 0xa5, 0x31, 0xe9, 0x3c, 0x1e, 0xe6, 0xc6,
+	0xc3, 0x01, 0x8e, 0xa3, 0x55, 0xf3, 0xf7, 0xb5, 0xa6, 0xab, 0x09, 0xd0, 0x19, 0x61, 0x3b, 0x02,
+	0x52, 0xb7, 0xd1, 0x0c, 0xcb, 0x70, 0x0f, 0x22, 0x2f, 0xeb, 0x46, 0xe0, 0x41, 0x82, 0xfd, 0x08,
+	0x9a, 0xda, 0x5f, 0x9c, 0x5d, 0x1f, 0xe6, 0x46, 0x4d, 0xb0, 0x5f, 0x53, 0x64, 0xba, 0xff, 0x89,
+	0x5d, 0xb7, 0x1b, 0xc1, 0x4b, 0xb1, 0xa7, 0x7e, 0x50, 0xd0, 0xff, 0xe5, 0x5a, 0xd6, 0xce, 0x80,
+	0xb6, 0x49, 0xd4, 0xd4, 0xc6, 0xea, 0x4a, 0x63, 0xca, 0xd9, 0x3e, 0xca, 0x8d, 0xca, 0xf7, 0xdc,
+	0x58, 0x6c, 0x85, 0xac, 0xdd, 0xf5, 0xad, 0x80, 0xc4, 0xb6, 0x0c, 0x52, 0xfc, 0x2c, 0xd1, 0x66,
+	0xc7, 0x66, 0x83, 0x14, 0xa8, 0xb5, 0x99, 0xb0, 0x61, 0x6e, 0xdc, 0xbf, 0x6a, 0xe0, 0x82, 0xd4,
+	0x74, 0x67, 0x46, 0x16, 0xde, 0x9e, 0xef, 0xaa, 0x1f, 0x15, 0xa4, 0x36, 0x21, 0x21, 0xb1, 0x17,
+	0x64, 0xc0, 0xd3, 0xf5, 0xf6, 0x00, 0xb4, 0xf1, 0xfa, 0x58, 0xa3, 0xfa, 0x74, 0xde, 0x12, 0x42,
+	0x56, 0x71, 0x71, 0x96, 0xbc, 0x38, 0x6b, 0x8d, 0x84, 0x89, 0xb3, 0x55, 0x98, 0x1b, 0xe6, 0xc6,
+	0xbc, 0x90, 0xbc, 0x4a, 0x61, 0x7e, 0x3d, 0x31, 0x1a, 0x7f, 0xe0, 0xbc, 0x60, 0xa3, 0xee, 0x34,
+	0x27, 0x58, 0x93, 0xfd, 0x1b, 0x00, 0x2a, 0xa0, 0x85, 0x38, 0x4c, 0x98, 0xd7, 0x0f, 0x59, 0x3b,
+	0x4c, 0xbc, 0x0c, 0x28, 0x64, 0x3d, 0xa0, 0x1e, 0x2f, 0xa4, 0xda, 0xdf, 0xf5, 0xb1, 0xc6, 0x94,
+	0xb3, 0x38, 0xcc, 0x0d, 0x53, 0x38, 0xb8, 0xa1, 0xd8, 0x74, 0xb5, 0x02, 0xdd, 0xe5, 0xa0, 0x2b,
+	0xb1, 0x75, 0x0e, 0xa9, 0x5f, 0x14, 0xc4, 0x41, 0x0f, 0xa7, 0x69, 0x46, 0x7a, 0x38, 0x1a, 0x25,
+	0x46, 0xb5, 0x89, 0xdb, 0x52, 0xd8, 0x91, 0x29, 0x18, 0x25, 0x0f, 0xd7, 0x10, 0xdd, 0x2d, 0x8b,
+	0xb9, 0x82, 0xe6, 0x85, 0x64, 0xb9, 0xb8, 0x29, 0xaa, 0x32, 0x34, 0x7b, 0x59, 0xa0, 0x1f, 0x26,
+	0x4d, 0xd2, 0xd7, 0xfe, 0xa9, 0x2b, 0xdc, 0xa5, 0x78, 0x2e, 0xd6, 0xf9, 0x73, 0xb1, 0xd6, 0xe5,
+	0x73, 0x71, 0x9e, 0x48, 0x97, 0x0b, 0xd7, 0xb9, 0x14, 0x24, 0xe6, 0xe1, 0x89, 0xa1, 0xb8, 0x6a,
+	0x59, 0x79, 0x97, 0x03, 0xea, 0x81, 0x82, 0xa6, 0x63, 0xbc, 0xef, 0x89, 0xae, 0x98, 0x74, 0x13,
+	0x46, 0xb5, 0xc9, 0xdb, 0x82, 0x79, 0x25, 0x25, 0xef, 0x49, 0xc9, 0x5f, 0x08, 0xee, 0x16, 0xc8,
+	0xbf, 0x31, 0xde, 0xdf, 0x2a, 0x9c, 0x89, 0xe6, 0xe2, 0xe1, 0x54, 0x69, 0x37, 0x4d, 0xa3, 0x81,
+	0x17, 0xe0, 0x94, 0x6a, 0x53, 0xb7, 0xb9, 0xd9, 0x90, 0x6e, 0x54, 0xe1, 0xa6, 0xd4, 0x7b, 0x37,
+	0x23, 0x48, 0x74, 0xae, 0xe1, 0x94, 0xaa, 0xcf, 0x51, 0xf5, 0xfc, 0x54, 0xc5, 0x8c, 0x41, 0x75,
+	0xa5, 0x31, 0xee, 0xcc, 0x8d, 0x44, 0x4a, 0xa0, 0xe9, 0x22, 0x79, 0x82, 0x62, 0x8c, 0x74, 0xd0,
+	0x03, 0x1c, 0x45, 0xa4, 0x7f, 0x69, 0xfc, 0x84, 0x7e, 0xe0, 0x65, 0x10, 0x40, 0xd8, 0x03, 0xad,
+	0xca, 0x07, 0x4a, 0x63, 0x98, 0x1b, 0x8f, 0x04, 0xd5, 0x8d, 0xe5, 0xa6, 0x5b, 0xe3, 0x78, 0x69,
+	0x60, 0x6d, 0xfa, 0x81, 0x2b, 0xc0, 0xd5, 0xf1, 0xc3, 0x4f, 0x46, 0xc5, 0x79, 0x7d, 0x74, 0xaa,
+	0x2b, 0xc7, 0xa7, 0xba, 0xf2, 0xe3, 0x54, 0x57, 0x0e, 0xce, 0xf4, 0xca, 0xf1, 0x99, 0x5e, 0xf9,
+	0x76, 0xa6, 0x57, 0xde, 0xad, 0x94, 0xce, 0xce, 0x07, 0xf7, 0x12, 0xa6, 0x14, 0x18, 0x15, 0x0b,
+	0xbb, 0xb7, 0x62, 0xef, 0xdb, 0x97, 0x66, 0x3d, 0x8f, 0xc3, 0x9f, 0xe0, 0x7f, 0xb2, 0x67, 0x3f,
+	0x07, 0x00, 0x96, 0xea, 0xb5, 0xa2, 0x08, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -99,6 +243,124 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AllowBlacklistedIbcReceive {
+		i--
+		if m.AllowBlacklistedIbcReceive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxMinters != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMinters))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.SupplyCaps) > 0 {
+		for iNdEx := len(m.SupplyCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplyCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.MaxMintAmounts) > 0 {
+		for iNdEx := len(m.MaxMintAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxMintAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MintApprovalWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MintApprovalWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.MintApprovalThresholds) > 0 {
+		for iNdEx := len(m.MintApprovalThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintApprovalThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MintWithinReservesDenoms) > 0 {
+		for iNdEx := len(m.MintWithinReservesDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MintWithinReservesDenoms[iNdEx])
+			copy(dAtA[i:], m.MintWithinReservesDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.MintWithinReservesDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DenomCreationFee) > 0 {
+		for iNdEx := len(m.DenomCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.TravelRuleThreshold.Size()
+		i -= size
+		if _, err := m.TravelRuleThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TravelRuleEnabled {
+		i--
+		if m.TravelRuleEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RejectBlacklistedSigners {
+		i--
+		if m.RejectBlacklistedSigners {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -119,6 +381,52 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.RejectBlacklistedSigners {
+		n += 2
+	}
+	if m.TravelRuleEnabled {
+		n += 2
+	}
+	l = m.TravelRuleThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.DenomCreationFee) > 0 {
+		for _, e := range m.DenomCreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.MintWithinReservesDenoms) > 0 {
+		for _, s := range m.MintWithinReservesDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.MintApprovalThresholds) > 0 {
+		for _, e := range m.MintApprovalThresholds {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MintApprovalWindow)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.MaxMintAmounts) > 0 {
+		for _, e := range m.MaxMintAmounts {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.SupplyCaps) > 0 {
+		for _, e := range m.SupplyCaps {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxMinters != 0 {
+		n += 1 + sovParams(uint64(m.MaxMinters))
+	}
+	if m.AllowBlacklistedIbcReceive {
+		n += 2
+	}
 	return n
 }
 
@@ -157,6 +465,320 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectBlacklistedSigners", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RejectBlacklistedSigners = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TravelRuleEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TravelRuleEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TravelRuleThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TravelRuleThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCreationFee = append(m.DenomCreationFee, types.Coin{})
+			if err := m.DenomCreationFee[len(m.DenomCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintWithinReservesDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintWithinReservesDenoms = append(m.MintWithinReservesDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintApprovalThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintApprovalThresholds = append(m.MintApprovalThresholds, types.Coin{})
+			if err := m.MintApprovalThresholds[len(m.MintApprovalThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintApprovalWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MintApprovalWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMintAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxMintAmounts = append(m.MaxMintAmounts, types.Coin{})
+			if err := m.MaxMintAmounts[len(m.MaxMintAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplyCaps = append(m.SupplyCaps, types.Coin{})
+			if err := m.SupplyCaps[len(m.SupplyCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMinters", wireType)
+			}
+			m.MaxMinters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMinters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowBlacklistedIbcReceive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowBlacklistedIbcReceive = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
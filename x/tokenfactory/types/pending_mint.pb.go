@@ -0,0 +1,524 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/pending_mint.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingMint is a mint above the approval threshold of its denom, waiting
+// for a minter controller of its minter to approve it.
+type PendingMint struct {
+	Id      uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Minter  string     `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty"`
+	Address string     `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// expires_at is the time after which the mint can no longer be approved.
+	ExpiresAt time.Time `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+}
+
+func (m *PendingMint) Reset()         { *m = PendingMint{} }
+func (m *PendingMint) String() string { return proto.CompactTextString(m) }
+func (*PendingMint) ProtoMessage()    {}
+func (*PendingMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9344542c5de8a7a4, []int{0}
+}
+func (m *PendingMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingMint.Merge(m, src)
+}
+func (m *PendingMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingMint proto.InternalMessageInfo
+
+func (m *PendingMint) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PendingMint) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
+}
+
+func (m *PendingMint) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PendingMint) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *PendingMint) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*PendingMint)(nil), "noble.tokenfactory.PendingMint")
+}
+
+func init() { proto.RegisterFile("tokenfactory/pending_mint.proto", fileDescriptor_9344542c5de8a7a4) }
+
+var fileDescriptor_9344542c5de8a7a4 = []byte{
+	// 330 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x3f, 0x6f, 0xea, 0x30,
+	0x14, 0xc5, 0x63, 0x1e, 0x8f, 0xf7, 0x30, 0xd2, 0x1b, 0xac, 0xa7, 0x2a, 0x65, 0x70, 0x50, 0x27,
+	0x96, 0xda, 0xa2, 0x15, 0xea, 0x5c, 0x98, 0xab, 0x56, 0x51, 0xa7, 0x2e, 0xc8, 0x49, 0x4c, 0x6a,
+	0x95, 0xf8, 0x46, 0xf1, 0x05, 0xc1, 0xb7, 0xe0, 0x63, 0xb1, 0x54, 0x62, 0xec, 0xd4, 0x56, 0xf0,
+	0x45, 0xaa, 0xfc, 0x41, 0x6a, 0xb7, 0x7b, 0xec, 0x73, 0xe4, 0xdf, 0xf1, 0xa5, 0x01, 0xc2, 0x8b,
+	0xb6, 0x73, 0x15, 0x23, 0x14, 0x1b, 0x99, 0x6b, 0x9b, 0x18, 0x9b, 0xce, 0x32, 0x63, 0x51, 0xe4,
+	0x05, 0x20, 0x30, 0x66, 0x21, 0x5a, 0x68, 0xf1, 0xdd, 0xd6, 0xe7, 0x31, 0xb8, 0x0c, 0x9c, 0x8c,
+	0x94, 0xd3, 0x72, 0x35, 0x8a, 0x34, 0xaa, 0x91, 0x8c, 0xc1, 0xd8, 0x3a, 0xd3, 0xff, 0x9f, 0x42,
+	0x0a, 0xd5, 0x28, 0xcb, 0xa9, 0x39, 0x0d, 0x52, 0x80, 0x74, 0xa1, 0x65, 0xa5, 0xa2, 0xe5, 0x5c,
+	0xa2, 0xc9, 0xb4, 0x43, 0x95, 0xe5, 0xb5, 0xe1, 0xe2, 0x95, 0xd0, 0xde, 0x43, 0x4d, 0x70, 0x67,
+	0x2c, 0xb2, 0x7f, 0xb4, 0x65, 0x12, 0x9f, 0x0c, 0xc8, 0xb0, 0x1d, 0xb6, 0x4c, 0xc2, 0xce, 0x68,
+	0xa7, 0x04, 0xd3, 0x85, 0xdf, 0x1a, 0x90, 0x61, 0x37, 0x6c, 0x14, 0xf3, 0xe9, 0x1f, 0x95, 0x24,
+	0x85, 0x76, 0xce, 0xff, 0x55, 0x5d, 0x9c, 0x24, 0xbb, 0xa1, 0x1d, 0x95, 0xc1, 0xd2, 0xa2, 0xdf,
+	0x1e, 0x90, 0x61, 0xef, 0xea, 0x5c, 0xd4, 0xe4, 0xa2, 0x24, 0x17, 0x0d, 0xb9, 0x98, 0x82, 0xb1,
+	0x93, 0xf6, 0xee, 0x3d, 0xf0, 0xc2, 0xc6, 0xce, 0xa6, 0x94, 0xea, 0x75, 0x6e, 0x0a, 0xed, 0x66,
+	0x0a, 0xfd, 0xdf, 0x55, 0xb8, 0x2f, 0xea, 0x02, 0xe2, 0x54, 0x40, 0x3c, 0x9e, 0x0a, 0x4c, 0xfe,
+	0x96, 0xe9, 0xed, 0x47, 0x40, 0xc2, 0x6e, 0x93, 0xbb, 0xc5, 0xc9, 0xfd, 0xee, 0xc0, 0xc9, 0xfe,
+	0xc0, 0xc9, 0xe7, 0x81, 0x93, 0xed, 0x91, 0x7b, 0xfb, 0x23, 0xf7, 0xde, 0x8e, 0xdc, 0x7b, 0x1a,
+	0xa7, 0x06, 0x9f, 0x97, 0x91, 0x88, 0x21, 0x93, 0xd5, 0xff, 0x5e, 0x2a, 0xe7, 0x34, 0xba, 0x5a,
+	0xc8, 0xd5, 0x58, 0xae, 0xe5, 0x8f, 0xc5, 0xe0, 0x26, 0xd7, 0x2e, 0xea, 0x54, 0x2f, 0x5f, 0x7f,
+	0x0d, 0x00, 0xe4, 0x52, 0xd5, 0x02, 0xb5, 0x01, 0x00, 0x00,
+}
+
+func (m *PendingMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPendingMint(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPendingMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPendingMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintPendingMint(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintPendingMint(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPendingMint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPendingMint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPendingMint(uint64(m.Id))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovPendingMint(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPendingMint(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovPendingMint(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovPendingMint(uint64(l))
+	return n
+}
+
+func sovPendingMint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPendingMint(x uint64) (n int) {
+	return sovPendingMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPendingMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPendingMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPendingMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPendingMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPendingMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPendingMint(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPendingMint
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPendingMint
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPendingMint
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPendingMint
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPendingMint
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPendingMint
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPendingMint        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPendingMint          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPendingMint = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,637 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/redemption.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RedemptionStatus is the stage a Redemption is at.
+type RedemptionStatus int32
+
+const (
+	// REDEMPTION_STATUS_UNSPECIFIED matches every status in queries.
+	REDEMPTION_STATUS_UNSPECIFIED RedemptionStatus = 0
+	// REDEMPTION_STATUS_PENDING is a redemption whose amount is in escrow.
+	REDEMPTION_STATUS_PENDING RedemptionStatus = 1
+	// REDEMPTION_STATUS_FINALIZED is a redemption whose amount was burned.
+	REDEMPTION_STATUS_FINALIZED RedemptionStatus = 2
+	// REDEMPTION_STATUS_CANCELLED is a redemption whose amount was refunded.
+	REDEMPTION_STATUS_CANCELLED RedemptionStatus = 3
+)
+
+var RedemptionStatus_name = map[int32]string{
+	0: "REDEMPTION_STATUS_UNSPECIFIED",
+	1: "REDEMPTION_STATUS_PENDING",
+	2: "REDEMPTION_STATUS_FINALIZED",
+	3: "REDEMPTION_STATUS_CANCELLED",
+}
+
+var RedemptionStatus_value = map[string]int32{
+	"REDEMPTION_STATUS_UNSPECIFIED": 0,
+	"REDEMPTION_STATUS_PENDING":     1,
+	"REDEMPTION_STATUS_FINALIZED":   2,
+	"REDEMPTION_STATUS_CANCELLED":   3,
+}
+
+func (x RedemptionStatus) String() string {
+	return proto.EnumName(RedemptionStatus_name, int32(x))
+}
+
+func (RedemptionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c57d77d93e0faa2b, []int{0}
+}
+
+// Redemption is a request of a holder to redeem an amount of a minting denom
+// off-chain, which holds the amount in the escrow of the module until a
+// minter finalizes or cancels it.
+type Redemption struct {
+	Id       uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Redeemer string     `protobuf:"bytes,2,opt,name=redeemer,proto3" json:"redeemer,omitempty"`
+	Amount   types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// bank_reference identifies the off-chain settlement of the redemption,
+	// like the bank account or wire the redeemer is paid to.
+	BankReference string           `protobuf:"bytes,4,opt,name=bank_reference,json=bankReference,proto3" json:"bank_reference,omitempty"`
+	Status        RedemptionStatus `protobuf:"varint,5,opt,name=status,proto3,enum=noble.tokenfactory.RedemptionStatus" json:"status,omitempty"`
+	// minter is the minter who finalized or cancelled the redemption.
+	Minter string `protobuf:"bytes,6,opt,name=minter,proto3" json:"minter,omitempty"`
+	// height is the block height the redemption was requested at.
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *Redemption) Reset()         { *m = Redemption{} }
+func (m *Redemption) String() string { return proto.CompactTextString(m) }
+func (*Redemption) ProtoMessage()    {}
+func (*Redemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c57d77d93e0faa2b, []int{0}
+}
+func (m *Redemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Redemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Redemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Redemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Redemption.Merge(m, src)
+}
+func (m *Redemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *Redemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_Redemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Redemption proto.InternalMessageInfo
+
+func (m *Redemption) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Redemption) GetRedeemer() string {
+	if m != nil {
+		return m.Redeemer
+	}
+	return ""
+}
+
+func (m *Redemption) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *Redemption) GetBankReference() string {
+	if m != nil {
+		return m.BankReference
+	}
+	return ""
+}
+
+func (m *Redemption) GetStatus() RedemptionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return REDEMPTION_STATUS_UNSPECIFIED
+}
+
+func (m *Redemption) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
+}
+
+func (m *Redemption) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("noble.tokenfactory.RedemptionStatus", RedemptionStatus_name, RedemptionStatus_value)
+	proto.RegisterType((*Redemption)(nil), "noble.tokenfactory.Redemption")
+}
+
+func init() { proto.RegisterFile("tokenfactory/redemption.proto", fileDescriptor_c57d77d93e0faa2b) }
+
+var fileDescriptor_c57d77d93e0faa2b = []byte{
+	// 431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
+	0x18, 0xf4, 0x3a, 0xc1, 0xc0, 0x22, 0x22, 0x6b, 0x85, 0x90, 0x1b, 0x14, 0xd7, 0x20, 0x90, 0x2c,
+	0x24, 0x76, 0xd5, 0xa2, 0x8a, 0x0b, 0x97, 0x34, 0x76, 0x91, 0xa5, 0xe0, 0x46, 0x4e, 0x7a, 0xe9,
+	0x25, 0xb2, 0x9d, 0xaf, 0x8e, 0x55, 0xbc, 0x1b, 0x79, 0x37, 0x15, 0x7d, 0x01, 0xc4, 0x91, 0x07,
+	0xe0, 0xc6, 0xcb, 0xf4, 0xd8, 0x23, 0x27, 0x84, 0x92, 0x17, 0x41, 0x76, 0x4c, 0xf9, 0x49, 0x6f,
+	0xdf, 0xcc, 0xce, 0xcc, 0xce, 0xfe, 0xe0, 0x9e, 0x12, 0xe7, 0xc0, 0xcf, 0xe2, 0x54, 0x89, 0xf2,
+	0x92, 0x95, 0x30, 0x83, 0x62, 0xa1, 0x72, 0xc1, 0xe9, 0xa2, 0x14, 0x4a, 0x10, 0xc2, 0x45, 0xf2,
+	0x01, 0xe8, 0xdf, 0xa2, 0xae, 0x9d, 0x0a, 0x59, 0x08, 0xc9, 0x92, 0x58, 0x02, 0xbb, 0xd8, 0x4b,
+	0x40, 0xc5, 0x7b, 0x2c, 0x15, 0x79, 0xe3, 0xe9, 0x3e, 0xca, 0x44, 0x26, 0xea, 0x91, 0x55, 0xd3,
+	0x86, 0x7d, 0xf6, 0x49, 0xc7, 0x38, 0xba, 0x89, 0x27, 0x1d, 0xac, 0xe7, 0x33, 0x0b, 0x39, 0xc8,
+	0x6d, 0x47, 0x7a, 0x3e, 0x23, 0x5d, 0x7c, 0xaf, 0xda, 0x1c, 0x0a, 0x28, 0x2d, 0xdd, 0x41, 0xee,
+	0xfd, 0xe8, 0x06, 0x93, 0x37, 0xd8, 0x88, 0x0b, 0xb1, 0xe4, 0xca, 0x6a, 0x39, 0xc8, 0x7d, 0xb0,
+	0xbf, 0x43, 0x37, 0x0d, 0x68, 0xd5, 0x80, 0x36, 0x0d, 0xe8, 0x40, 0xe4, 0xfc, 0xb0, 0x7d, 0xf5,
+	0x63, 0x57, 0x8b, 0x1a, 0x39, 0x79, 0x81, 0x3b, 0x49, 0xcc, 0xcf, 0xa7, 0x25, 0x9c, 0x41, 0x09,
+	0x3c, 0x05, 0xab, 0x5d, 0x47, 0x3f, 0xac, 0xd8, 0xe8, 0x37, 0x49, 0xde, 0x62, 0x43, 0xaa, 0x58,
+	0x2d, 0xa5, 0x75, 0xc7, 0x41, 0x6e, 0x67, 0xff, 0x39, 0xdd, 0x3e, 0x35, 0xfd, 0xd3, 0x7d, 0x5c,
+	0x6b, 0xa3, 0xc6, 0x43, 0x1e, 0x63, 0xa3, 0xc8, 0xb9, 0x82, 0xd2, 0x32, 0xea, 0xf0, 0x06, 0x55,
+	0xfc, 0x1c, 0xf2, 0x6c, 0xae, 0xac, 0xbb, 0x0e, 0x72, 0x5b, 0x51, 0x83, 0x5e, 0x7e, 0x45, 0xd8,
+	0xfc, 0x3f, 0x8c, 0x3c, 0xc5, 0xbd, 0xc8, 0xf7, 0xfc, 0xf7, 0xa3, 0x49, 0x70, 0x1c, 0x4e, 0xc7,
+	0x93, 0xfe, 0xe4, 0x64, 0x3c, 0x3d, 0x09, 0xc7, 0x23, 0x7f, 0x10, 0x1c, 0x05, 0xbe, 0x67, 0x6a,
+	0xa4, 0x87, 0x77, 0xb6, 0x25, 0x23, 0x3f, 0xf4, 0x82, 0xf0, 0x9d, 0x89, 0xc8, 0x2e, 0x7e, 0xb2,
+	0xbd, 0x7c, 0x14, 0x84, 0xfd, 0x61, 0x70, 0xea, 0x7b, 0xa6, 0x7e, 0xbb, 0x60, 0xd0, 0x0f, 0x07,
+	0xfe, 0x70, 0xe8, 0x7b, 0x66, 0xab, 0xdb, 0xfe, 0xfc, 0xcd, 0xd6, 0x0e, 0x8f, 0xaf, 0x56, 0x36,
+	0xba, 0x5e, 0xd9, 0xe8, 0xe7, 0xca, 0x46, 0x5f, 0xd6, 0xb6, 0x76, 0xbd, 0xb6, 0xb5, 0xef, 0x6b,
+	0x5b, 0x3b, 0x3d, 0xc8, 0x72, 0x35, 0x5f, 0x26, 0x34, 0x15, 0x05, 0xab, 0x2f, 0xe8, 0x55, 0x2c,
+	0x25, 0x28, 0xb9, 0x01, 0xec, 0xe2, 0x80, 0x7d, 0x64, 0xff, 0xfc, 0x26, 0x75, 0xb9, 0x00, 0x99,
+	0x18, 0xf5, 0xfb, 0xbf, 0xfe, 0x35, 0x00, 0x6c, 0x89, 0x5d, 0xff, 0x6a, 0x02, 0x00, 0x00,
+}
+
+func (m *Redemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Redemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Redemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintRedemption(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintRedemption(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Status != 0 {
+		i = encodeVarintRedemption(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BankReference) > 0 {
+		i -= len(m.BankReference)
+		copy(dAtA[i:], m.BankReference)
+		i = encodeVarintRedemption(dAtA, i, uint64(len(m.BankReference)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRedemption(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Redeemer) > 0 {
+		i -= len(m.Redeemer)
+		copy(dAtA[i:], m.Redeemer)
+		i = encodeVarintRedemption(dAtA, i, uint64(len(m.Redeemer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRedemption(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRedemption(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRedemption(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Redemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRedemption(uint64(m.Id))
+	}
+	l = len(m.Redeemer)
+	if l > 0 {
+		n += 1 + l + sovRedemption(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRedemption(uint64(l))
+	l = len(m.BankReference)
+	if l > 0 {
+		n += 1 + l + sovRedemption(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovRedemption(uint64(m.Status))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovRedemption(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRedemption(uint64(m.Height))
+	}
+	return n
+}
+
+func sovRedemption(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRedemption(x uint64) (n int) {
+	return sovRedemption(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Redemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRedemption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Redemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Redemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redeemer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redeemer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RedemptionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRedemption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRedemption(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRedemption
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRedemption
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRedemption
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRedemption
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRedemption        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRedemption          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRedemption = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TravelRuleMemo is the tx memo required on transfers of the minting denom
+// above the travel rule threshold, e.g.
+//
+//	{"travel_rule":{"reference":"tr-123","originator_vasp":"vasp-a","beneficiary_vasp":"vasp-b"}}
+type TravelRuleMemo struct {
+	TravelRule *TravelRuleReference `json:"travel_rule"`
+}
+
+// TravelRuleReference identifies the travel rule data exchanged off chain
+// between the originating and beneficiary VASPs.
+type TravelRuleReference struct {
+	Reference       string `json:"reference"`
+	OriginatorVASP  string `json:"originator_vasp,omitempty"`
+	BeneficiaryVASP string `json:"beneficiary_vasp,omitempty"`
+}
+
+// ParseTravelRuleMemo parses memo as a TravelRuleMemo, which must carry a
+// non-empty reference.
+func ParseTravelRuleMemo(memo string) (TravelRuleReference, error) {
+	var parsed TravelRuleMemo
+	if err := json.Unmarshal([]byte(memo), &parsed); err != nil {
+		return TravelRuleReference{}, sdkerrors.Wrapf(ErrTravelRule, "invalid travel rule memo: %s", err)
+	}
+	if parsed.TravelRule == nil {
+		return TravelRuleReference{}, sdkerrors.Wrap(ErrTravelRule, "travel rule memo is missing the travel_rule object")
+	}
+	if strings.TrimSpace(parsed.TravelRule.Reference) == "" {
+		return TravelRuleReference{}, sdkerrors.Wrap(ErrTravelRule, "travel rule memo is missing the reference")
+	}
+	return *parsed.TravelRule, nil
+}
@@ -0,0 +1,26 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/noble/v5/x/tokenfactory/types"
+)
+
+func TestParseTravelRuleMemo(t *testing.T) {
+	reference, err := types.ParseTravelRuleMemo(`{"travel_rule":{"reference":"tr-1","beneficiary_vasp":"vasp-b"}}`)
+	require.NoError(t, err)
+	require.Equal(t, types.TravelRuleReference{Reference: "tr-1", BeneficiaryVASP: "vasp-b"}, reference)
+
+	for _, memo := range []string{
+		"",
+		"tr-1",
+		`{"forward":{"receiver":"noble1"}}`,
+		`{"travel_rule":{"reference":" "}}`,
+		`{"travel_rule":"tr-1"}`,
+	} {
+		_, err := types.ParseTravelRuleMemo(memo)
+		require.ErrorIs(t, err, types.ErrTravelRule, memo)
+	}
+}